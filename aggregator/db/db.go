@@ -60,6 +60,64 @@ func Migrate() {
 	  network_tx_bytes  BIGINT    NOT NULL
 	);
 
+	CREATE TABLE IF NOT EXISTS node_disk_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  device            TEXT      NOT NULL,
+	  read_bytes        BIGINT    NOT NULL,
+	  write_bytes       BIGINT    NOT NULL,
+	  read_count        BIGINT    NOT NULL,
+	  write_count       BIGINT    NOT NULL,
+	  io_time           BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS node_interface_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  interface_name    TEXT      NOT NULL,
+	  rx_bytes          BIGINT    NOT NULL,
+	  tx_bytes          BIGINT    NOT NULL,
+	  rx_packets        BIGINT    NOT NULL,
+	  tx_packets        BIGINT    NOT NULL,
+	  rx_errors         BIGINT    NOT NULL,
+	  tx_errors         BIGINT    NOT NULL,
+	  rx_dropped        BIGINT    NOT NULL,
+	  tx_dropped        BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS node_cpu_metrics (
+	  id                 SERIAL PRIMARY KEY,
+	  timestamp          TIMESTAMP NOT NULL,
+	  node_name          TEXT      NOT NULL,
+	  cpu                TEXT      NOT NULL,
+	  user_seconds       DOUBLE PRECISION NOT NULL,
+	  nice_seconds       DOUBLE PRECISION NOT NULL,
+	  system_seconds     DOUBLE PRECISION NOT NULL,
+	  idle_seconds       DOUBLE PRECISION NOT NULL,
+	  iowait_seconds     DOUBLE PRECISION NOT NULL,
+	  irq_seconds        DOUBLE PRECISION NOT NULL,
+	  softirq_seconds    DOUBLE PRECISION NOT NULL,
+	  steal_seconds      DOUBLE PRECISION NOT NULL,
+	  guest_seconds      DOUBLE PRECISION NOT NULL,
+	  guest_nice_seconds DOUBLE PRECISION NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS node_filesystem_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  mountpoint        TEXT      NOT NULL,
+	  device            TEXT      NOT NULL,
+	  fs_type           TEXT      NOT NULL,
+	  size_bytes        BIGINT    NOT NULL,
+	  used_bytes        BIGINT    NOT NULL,
+	  avail_bytes       BIGINT    NOT NULL,
+	  inodes_total      BIGINT    NOT NULL,
+	  inodes_used       BIGINT    NOT NULL
+	);
+
 	CREATE TABLE IF NOT EXISTS pod_metrics (
 	  id                SERIAL PRIMARY KEY,
 	  timestamp         TIMESTAMP NOT NULL,
@@ -76,8 +134,316 @@ func Migrate() {
 	  node_name         TEXT      NOT NULL
 	);
 
+	CREATE TABLE IF NOT EXISTS container_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  pod_name          TEXT      NOT NULL,
+	  pod_uid           TEXT      NOT NULL,
+	  container_id      TEXT      NOT NULL,
+	  cpu_usage_usec    BIGINT    NOT NULL,
+	  memory_usage      BIGINT    NOT NULL,
+	  disk_read_bytes   BIGINT    NOT NULL,
+	  disk_write_bytes  BIGINT    NOT NULL,
+	  namespace_name    TEXT      NOT NULL,
+	  node_name         TEXT      NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS node_pressure_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  resource          TEXT      NOT NULL,
+	  some_avg10        REAL      NOT NULL,
+	  some_avg60        REAL      NOT NULL,
+	  some_avg300       REAL      NOT NULL,
+	  some_total        BIGINT    NOT NULL,
+	  full_avg10        REAL      NOT NULL,
+	  full_avg60        REAL      NOT NULL,
+	  full_avg300       REAL      NOT NULL,
+	  full_total        BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS pod_pressure_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  pod_name          TEXT      NOT NULL,
+	  pod_uid           TEXT      NOT NULL,
+	  namespace_name    TEXT      NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  resource          TEXT      NOT NULL,
+	  some_avg10        REAL      NOT NULL,
+	  some_avg60        REAL      NOT NULL,
+	  some_avg300       REAL      NOT NULL,
+	  some_total        BIGINT    NOT NULL,
+	  full_avg10        REAL      NOT NULL,
+	  full_avg60        REAL      NOT NULL,
+	  full_avg300       REAL      NOT NULL,
+	  full_total        BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS pod_disk_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  pod_name          TEXT      NOT NULL,
+	  pod_uid           TEXT      NOT NULL,
+	  namespace_name    TEXT      NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  device            TEXT      NOT NULL,
+	  major             BIGINT    NOT NULL,
+	  minor             BIGINT    NOT NULL,
+	  read_bytes        BIGINT    NOT NULL,
+	  write_bytes       BIGINT    NOT NULL,
+	  read_count        BIGINT    NOT NULL,
+	  write_count       BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS pod_interface_metrics (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  pod_name          TEXT      NOT NULL,
+	  pod_uid           TEXT      NOT NULL,
+	  namespace_name    TEXT      NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  interface_name    TEXT      NOT NULL,
+	  rx_bytes          BIGINT    NOT NULL,
+	  tx_bytes          BIGINT    NOT NULL,
+	  rx_packets        BIGINT    NOT NULL,
+	  tx_packets        BIGINT    NOT NULL,
+	  rx_errors         BIGINT    NOT NULL,
+	  tx_errors         BIGINT    NOT NULL,
+	  rx_dropped        BIGINT    NOT NULL,
+	  tx_dropped        BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS pod_events (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  pod_name          TEXT      NOT NULL,
+	  pod_uid           TEXT      NOT NULL,
+	  namespace_name    TEXT      NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  event_type        TEXT      NOT NULL,
+	  count             BIGINT    NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS collector_errors (
+	  id                SERIAL PRIMARY KEY,
+	  timestamp         TIMESTAMP NOT NULL,
+	  node_name         TEXT      NOT NULL,
+	  subsystem         TEXT      NOT NULL,
+	  pod_uid           TEXT,
+	  container_id      TEXT,
+	  message           TEXT      NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS ingested_samples (
+	  idempotency_key   TEXT      PRIMARY KEY,
+	  received_at       TIMESTAMP NOT NULL
+	);
+
+	-- CPU 모드별 시간은 긴 가동 시간에도 짧은 구간의 차이를 계산할 수 있도록 DOUBLE PRECISION 으로 저장합니다
+	ALTER TABLE node_metrics
+	  ADD COLUMN IF NOT EXISTS cpu_count              INTEGER          NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_user_seconds       DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_nice_seconds       DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_system_seconds     DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_idle_seconds       DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_iowait_seconds     DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_irq_seconds        DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_softirq_seconds    DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_steal_seconds      DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_guest_seconds      DOUBLE PRECISION NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_guest_nice_seconds DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+	-- cpu_total, cpu_busy 도 누적 초이므로 REAL 로는 가동 시간이 길어지면 짧은 구간의 차이가 사라집니다
+	-- 타입 변경은 테이블 전체를 다시 쓰므로 아직 REAL 인 경우에만 실행합니다
+	DO $$
+	BEGIN
+	  IF EXISTS (
+	    SELECT 1 FROM information_schema.columns
+	    WHERE table_schema = current_schema()
+	      AND table_name = 'node_metrics'
+	      AND column_name IN ('cpu_total', 'cpu_busy')
+	      AND data_type = 'real'
+	  ) THEN
+	    ALTER TABLE node_metrics
+	      ALTER COLUMN cpu_total TYPE DOUBLE PRECISION,
+	      ALTER COLUMN cpu_busy  TYPE DOUBLE PRECISION;
+	  END IF;
+	END $$;
+
+	ALTER TABLE node_metrics
+	  ADD COLUMN IF NOT EXISTS memory_buffers            BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_cached             BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_dirty              BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_slab_reclaimable   BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_slab_unreclaimable BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS swap_total                BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS swap_used                 BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS hugepages_total           BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS hugepages_free            BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS hugepage_size             BIGINT NOT NULL DEFAULT 0;
+
+	-- boot_id 가 바뀌면 노드가 재부팅되어 누적 카운터가 초기화된 것입니다
+	ALTER TABLE node_metrics
+	  ADD COLUMN IF NOT EXISTS boot_id          TEXT,
+	  ADD COLUMN IF NOT EXISTS boot_time        TIMESTAMP,
+	  ADD COLUMN IF NOT EXISTS uptime_seconds   BIGINT,
+	  ADD COLUMN IF NOT EXISTS load1            DOUBLE PRECISION,
+	  ADD COLUMN IF NOT EXISTS load5            DOUBLE PRECISION,
+	  ADD COLUMN IF NOT EXISTS load15           DOUBLE PRECISION,
+	  ADD COLUMN IF NOT EXISTS context_switches BIGINT,
+	  ADD COLUMN IF NOT EXISTS procs_total      BIGINT,
+	  ADD COLUMN IF NOT EXISTS procs_running    BIGINT,
+	  ADD COLUMN IF NOT EXISTS procs_blocked    BIGINT;
+
+	ALTER TABLE node_disk_metrics
+	  ADD COLUMN IF NOT EXISTS merged_read_count  BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS merged_write_count BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS read_time          BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS write_time         BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS weighted_io_time   BIGINT NOT NULL DEFAULT 0;
+
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS memory_working_set BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_rss         BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_cache       BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_kernel      BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_sock        BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_swap        BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_nr_periods     BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_nr_throttled   BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_throttled_usec BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_high_events BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS memory_max_events  BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS oom_events         BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS oom_kill_events    BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS ephemeral_empty_dir_bytes      BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS ephemeral_writable_layer_bytes BIGINT NOT NULL DEFAULT 0;
+
+	ALTER TABLE pod_metrics
+	  ALTER COLUMN ephemeral_empty_dir_bytes      DROP NOT NULL,
+	  ALTER COLUMN ephemeral_writable_layer_bytes DROP NOT NULL;
+
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS pids_current BIGINT,
+	  ADD COLUMN IF NOT EXISTS pids_max     BIGINT,
+	  ADD COLUMN IF NOT EXISTS open_fds     BIGINT;
+
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS disk_read_count  BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS disk_write_count BIGINT NOT NULL DEFAULT 0;
+
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS tcp_established      BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_syn_sent         BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_syn_recv         BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_fin_wait         BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_time_wait        BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_close_wait       BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_last_ack         BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_listen           BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_out_segs         BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_retrans_segs     BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_listen_overflows BIGINT,
+	  ADD COLUMN IF NOT EXISTS tcp_listen_drops     BIGINT;
+
+	-- host_network 파드의 network_*, tcp_* 값은 노드 전체의 값이므로 네임스페이스, 디플로이먼트 합계에서 제외합니다
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS host_network BOOLEAN NOT NULL DEFAULT FALSE;
+
+	-- 일부 필드 묶음(cpu, memory, disk, network 등)을 수집하지 못한 메트릭도 행은 저장하고 해당 컬럼만 NULL 로 저장합니다
+	ALTER TABLE node_metrics
+	  ALTER COLUMN cpu_total                 DROP NOT NULL,
+	  ALTER COLUMN cpu_busy                  DROP NOT NULL,
+	  ALTER COLUMN cpu_count                 DROP NOT NULL,
+	  ALTER COLUMN cpu_user_seconds          DROP NOT NULL,
+	  ALTER COLUMN cpu_nice_seconds          DROP NOT NULL,
+	  ALTER COLUMN cpu_system_seconds        DROP NOT NULL,
+	  ALTER COLUMN cpu_idle_seconds          DROP NOT NULL,
+	  ALTER COLUMN cpu_iowait_seconds        DROP NOT NULL,
+	  ALTER COLUMN cpu_irq_seconds           DROP NOT NULL,
+	  ALTER COLUMN cpu_softirq_seconds       DROP NOT NULL,
+	  ALTER COLUMN cpu_steal_seconds         DROP NOT NULL,
+	  ALTER COLUMN cpu_guest_seconds         DROP NOT NULL,
+	  ALTER COLUMN cpu_guest_nice_seconds    DROP NOT NULL,
+	  ALTER COLUMN memory_total              DROP NOT NULL,
+	  ALTER COLUMN memory_used               DROP NOT NULL,
+	  ALTER COLUMN memory_buffers            DROP NOT NULL,
+	  ALTER COLUMN memory_cached             DROP NOT NULL,
+	  ALTER COLUMN memory_dirty              DROP NOT NULL,
+	  ALTER COLUMN memory_slab_reclaimable   DROP NOT NULL,
+	  ALTER COLUMN memory_slab_unreclaimable DROP NOT NULL,
+	  ALTER COLUMN swap_total                DROP NOT NULL,
+	  ALTER COLUMN swap_used                 DROP NOT NULL,
+	  ALTER COLUMN hugepages_total           DROP NOT NULL,
+	  ALTER COLUMN hugepages_free            DROP NOT NULL,
+	  ALTER COLUMN hugepage_size             DROP NOT NULL,
+	  ALTER COLUMN disk_read_bytes           DROP NOT NULL,
+	  ALTER COLUMN disk_write_bytes          DROP NOT NULL,
+	  ALTER COLUMN network_rx_bytes          DROP NOT NULL,
+	  ALTER COLUMN network_tx_bytes          DROP NOT NULL;
+
+	ALTER TABLE pod_metrics
+	  ALTER COLUMN cpu_usage_usec     DROP NOT NULL,
+	  ALTER COLUMN cpu_nr_periods     DROP NOT NULL,
+	  ALTER COLUMN cpu_nr_throttled   DROP NOT NULL,
+	  ALTER COLUMN cpu_throttled_usec DROP NOT NULL,
+	  ALTER COLUMN memory_usage       DROP NOT NULL,
+	  ALTER COLUMN memory_working_set DROP NOT NULL,
+	  ALTER COLUMN memory_rss         DROP NOT NULL,
+	  ALTER COLUMN memory_cache       DROP NOT NULL,
+	  ALTER COLUMN memory_kernel      DROP NOT NULL,
+	  ALTER COLUMN memory_sock        DROP NOT NULL,
+	  ALTER COLUMN memory_swap        DROP NOT NULL,
+	  ALTER COLUMN memory_high_events DROP NOT NULL,
+	  ALTER COLUMN memory_max_events  DROP NOT NULL,
+	  ALTER COLUMN oom_events         DROP NOT NULL,
+	  ALTER COLUMN oom_kill_events    DROP NOT NULL,
+	  ALTER COLUMN disk_read_bytes    DROP NOT NULL,
+	  ALTER COLUMN disk_write_bytes   DROP NOT NULL,
+	  ALTER COLUMN disk_read_count    DROP NOT NULL,
+	  ALTER COLUMN disk_write_count   DROP NOT NULL,
+	  ALTER COLUMN network_rx_bytes   DROP NOT NULL,
+	  ALTER COLUMN network_tx_bytes   DROP NOT NULL;
+
+	-- 제한 값의 0 은 제한 없음이며, 제한 파일을 읽지 못한 경우 NULL 입니다
+	ALTER TABLE pod_metrics
+	  ADD COLUMN IF NOT EXISTS qos_class       TEXT,
+	  ADD COLUMN IF NOT EXISTS cpu_quota_usec  BIGINT,
+	  ADD COLUMN IF NOT EXISTS cpu_period_usec BIGINT,
+	  ADD COLUMN IF NOT EXISTS cpu_weight      BIGINT,
+	  ADD COLUMN IF NOT EXISTS memory_max      BIGINT,
+	  ADD COLUMN IF NOT EXISTS memory_high     BIGINT;
+
+	ALTER TABLE container_metrics
+	  ADD COLUMN IF NOT EXISTS cpu_nr_periods     BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_nr_throttled   BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS cpu_throttled_usec BIGINT NOT NULL DEFAULT 0,
+	  ADD COLUMN IF NOT EXISTS container_name     TEXT   NOT NULL DEFAULT '',
+	  ADD COLUMN IF NOT EXISTS image              TEXT   NOT NULL DEFAULT '',
+	  ADD COLUMN IF NOT EXISTS restart_count      BIGINT NOT NULL DEFAULT 0;
+
+	CREATE INDEX IF NOT EXISTS idx_node_disk_metrics_node ON node_disk_metrics (node_name);
+	CREATE INDEX IF NOT EXISTS idx_node_disk_metrics_timestamp ON node_disk_metrics (node_name, timestamp);
+	CREATE INDEX IF NOT EXISTS idx_node_interface_metrics_node ON node_interface_metrics (node_name);
+	CREATE INDEX IF NOT EXISTS idx_node_cpu_metrics_node ON node_cpu_metrics (node_name, timestamp);
+	CREATE INDEX IF NOT EXISTS idx_node_filesystem_metrics_node ON node_filesystem_metrics (node_name, timestamp);
 	CREATE INDEX IF NOT EXISTS idx_pod_metrics_namespace ON pod_metrics (namespace_name);
 	CREATE INDEX IF NOT EXISTS idx_pod_metrics_deployment ON pod_metrics (deployment_name);
+	CREATE INDEX IF NOT EXISTS idx_pod_metrics_timestamp ON pod_metrics (timestamp);
+	CREATE INDEX IF NOT EXISTS idx_pod_metrics_uid ON pod_metrics (uid);
+	CREATE INDEX IF NOT EXISTS idx_pod_events_namespace ON pod_events (namespace_name);
+	CREATE INDEX IF NOT EXISTS idx_container_metrics_pod ON container_metrics (pod_name);
+	CREATE INDEX IF NOT EXISTS idx_node_pressure_metrics_node ON node_pressure_metrics (node_name);
+	CREATE INDEX IF NOT EXISTS idx_pod_pressure_metrics_pod ON pod_pressure_metrics (pod_name);
+	CREATE INDEX IF NOT EXISTS idx_pod_interface_metrics_pod ON pod_interface_metrics (pod_uid, timestamp);
+	CREATE INDEX IF NOT EXISTS idx_collector_errors_node ON collector_errors (node_name, timestamp);
+	CREATE INDEX IF NOT EXISTS idx_ingested_samples_received_at ON ingested_samples (received_at);
+
+	-- 파드 디스크 메트릭은 파드 UID 로 조회하므로 pod_name 인덱스를 pod_uid 인덱스로 교체합니다
+	DROP INDEX IF EXISTS idx_pod_disk_metrics_pod;
+	CREATE INDEX IF NOT EXISTS idx_pod_disk_metrics_pod_uid ON pod_disk_metrics (pod_uid, timestamp);
 	`
 
 	if _, err := tx.Exec(ctx, schema); err != nil {
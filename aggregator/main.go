@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -49,14 +50,52 @@ func main() {
 	}()
 	kube.InitLister(stopCh)
 
-	job, err := s.NewJob(
-		gocron.CronJob("*/1 * * * *", false), // Every minute
-		gocron.NewTask(service.SaveMetrics),
+	// COLLECT_MODE 는 수집기 메트릭을 받는 방식입니다
+	// pull 은 aggregator 가 수집기를 주기적으로 조회하고, push 는 수집기가 ingest 엔드포인트로 전송합니다
+	collectMode := os.Getenv("COLLECT_MODE")
+	if collectMode == "" {
+		collectMode = "pull"
+	}
+	if collectMode != "pull" && collectMode != "push" && collectMode != "both" {
+		log.Fatal("Invalid COLLECT_MODE: ", collectMode)
+	}
+	log.Println("Collect mode:", collectMode)
+
+	if collectMode == "pull" || collectMode == "both" {
+		job, err := s.NewJob(
+			gocron.CronJob("*/1 * * * *", false), // Every minute
+			gocron.NewTask(service.SaveMetrics),
+		)
+		if err != nil {
+			log.Fatal("Failed to create job:", err)
+		}
+		log.Println("Job created successfully:", job.ID())
+	}
+
+	if collectMode == "push" || collectMode == "both" {
+		ingestAddr := os.Getenv("INGEST_ADDR")
+		if ingestAddr == "" {
+			ingestAddr = ":8080"
+		}
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/ingest", service.IngestMetrics)
+		go func() {
+			if err := http.ListenAndServe(ingestAddr, mux); err != nil {
+				log.Fatal("Failed to start ingest server:", err)
+			}
+		}()
+		log.Println("Ingest server listening on", ingestAddr)
+	}
+
+	pruneJob, err := s.NewJob(
+		gocron.CronJob("0 * * * *", false), // Every hour
+		gocron.NewTask(service.PruneIngestedSamples),
 	)
 	if err != nil {
 		log.Fatal("Failed to create job:", err)
 	}
-	log.Println("Job created successfully:", job.ID())
+	log.Println("Job created successfully:", pruneJob.ID())
 	s.Start()
 
 	<-stopCh
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/aggregator/db"
+)
+
+// cursors 는 노드별로 마지막으로 가져온 샘플의 타임스탬프를 보관합니다
+var cursors = &cursorStore{cursors: make(map[string]time.Time)}
+
+type cursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]time.Time
+}
+
+// get 은 노드의 커서를 반환합니다
+// 커서가 없으면 (aggregator 재시작 등) 데이터베이스에 저장된 가장 최근 타임스탬프부터 이어서 가져옵니다
+func (s *cursorStore) get(nodeName string) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cursor, ok := s.cursors[nodeName]; ok {
+		return cursor
+	}
+	if nodeName == "" {
+		return time.Time{}
+	}
+
+	var cursor *time.Time
+	err := db.Pool.QueryRow(context.Background(), `
+		SELECT MAX(timestamp) FROM node_metrics WHERE node_name = $1
+	`, nodeName).Scan(&cursor)
+	if err != nil {
+		log.Println("Failed to query latest timestamp for node", nodeName, "Error:", err)
+		return time.Time{}
+	}
+	if cursor == nil {
+		return time.Time{}
+	}
+
+	s.cursors[nodeName] = *cursor
+	return *cursor
+}
+
+func (s *cursorStore) set(nodeName string, cursor time.Time) {
+	if nodeName == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[nodeName] = cursor
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sharedTypes "github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// 파드 메모리 이벤트 종류 (cgroup memory.events 의 키와 동일)
+const (
+	eventTypeHigh    = "high"
+	eventTypeMax     = "max"
+	eventTypeOom     = "oom"
+	eventTypeOomKill = "oom_kill"
+)
+
+// recordMemoryEvents 는 직전 수집 값과 비교하여 증가한 memory.events 카운터를 pod_events 에 기록합니다
+// pod_metrics 에 이번 수집 값을 저장하기 전에 호출해야 합니다
+func recordMemoryEvents(ctx context.Context, tx pgx.Tx, timestamp time.Time, podName string, p sharedTypes.PodMetric, namespaceParam any, nodeName string) error {
+	var previous sharedTypes.PodMetric
+	err := tx.QueryRow(ctx, `
+		SELECT memory_high_events, memory_max_events, oom_events, oom_kill_events
+		FROM pod_metrics
+		WHERE uid = $1 AND timestamp < $2 AND memory_high_events IS NOT NULL
+		ORDER BY timestamp DESC
+		LIMIT 1
+	`, p.UID, timestamp).Scan(
+		&previous.MemoryHighEvents,
+		&previous.MemoryMaxEvents,
+		&previous.OomEvents,
+		&previous.OomKillEvents,
+	)
+	// 이전 행이 없으면 (처음 수집된 파드, aggregator 업그레이드 직후, 보관 기간 경과) 기준값이 없으므로 기록하지 않습니다
+	// 누적 값 전체를 이번 시각의 이벤트로 기록하면 과거의 이벤트가 지금 발생한 것처럼 보입니다
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query previous memory events for pod UID %s: %w", p.UID, err)
+	}
+
+	increments := map[string]uint64{
+		eventTypeHigh:    counterIncrement(p.MemoryHighEvents, previous.MemoryHighEvents),
+		eventTypeMax:     counterIncrement(p.MemoryMaxEvents, previous.MemoryMaxEvents),
+		eventTypeOom:     counterIncrement(p.OomEvents, previous.OomEvents),
+		eventTypeOomKill: counterIncrement(p.OomKillEvents, previous.OomKillEvents),
+	}
+
+	for eventType, count := range increments {
+		if count == 0 {
+			continue
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO pod_events (
+				timestamp,
+				pod_name,
+				pod_uid,
+				namespace_name,
+				node_name,
+				event_type,
+				count
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7
+			)
+		`, timestamp,
+			podName,
+			p.UID,
+			namespaceParam,
+			nodeName,
+			eventType,
+			count,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert pod event %s for pod UID %s: %w", eventType, p.UID, err)
+		}
+	}
+	return nil
+}
+
+// counterIncrement 는 누적 카운터의 증가분을 반환합니다
+// 카운터가 초기화된 경우(cgroup 재생성) 현재 값을 증가분으로 취급합니다
+func counterIncrement(current, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/aggregator/db"
+	sharedTypes "github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ingestedSampleRetention 은 중복 판단을 위해 idempotency key 를 보관하는 기간입니다
+// 수집기가 재시도하는 샘플의 나이보다 길어야 합니다
+const ingestedSampleRetention = 24 * time.Hour
+
+// maxIngestBodyBytes 는 push 요청 본문의 최대 크기입니다
+const maxIngestBodyBytes = 16 << 20
+
+// IngestMetrics 는 push 모드에서 수집기가 POST 한 샘플을 저장합니다
+// Idempotency-Key 헤더가 이미 저장된 키이면 저장하지 않고 성공으로 응답하므로 수집기는 안전하게 재시도할 수 있습니다
+// 저장에 실패하면 키를 기록하지 않고 일시적인 오류이면 503, 샘플을 저장할 수 없으면 422 로 응답합니다
+// 503 으로 응답한 샘플은 수집기가 재시도해도 중복으로 취급되지 않습니다
+func IngestMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var metric sharedTypes.Metric
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxIngestBodyBytes)).Decode(&metric); err != nil {
+		http.Error(w, "invalid metric: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if metric.NodeMetric.NodeName == "" || metric.Timestamp.IsZero() {
+		http.Error(w, "invalid metric: nodeName and timestamp are required", http.StatusBadRequest)
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		key = idempotencyKey(metric)
+	}
+
+	stored, err := storeMetrics([]keyedMetric{{Key: key, Metric: metric}})
+	if err != nil {
+		// 다시 보내도 저장할 수 없는 샘플을 503 으로 응답하면 수집기 큐의 맨 앞에 남아 이후 샘플이 모두 밀리므로
+		// 일시적인 오류에만 재시도를 요청하고, 그 외에는 422 로 응답하여 수집기가 샘플을 버리도록 합니다
+		if !isTransientStoreError(err) {
+			http.Error(w, "failed to store metric: "+err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		http.Error(w, "failed to store metric", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]bool{
+		"stored":    stored == 1,
+		"duplicate": stored == 0,
+	})
+}
+
+// isTransientStoreError 는 저장 실패가 연결, 트랜잭션 충돌처럼 재시도하면 성공할 수 있는 오류인지 확인합니다
+// 제약 조건 위반, 범위를 벗어난 값처럼 샘플 자체의 문제는 false 입니다
+func isTransientStoreError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code[:2] {
+		case "08", // connection exception
+			"40", // transaction rollback (serialization_failure, deadlock_detected)
+			"53", // insufficient resources
+			"57": // operator intervention (admin_shutdown, query_canceled)
+			return true
+		}
+		return pgErr.Code == "55P03" // lock_not_available
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		pgconn.Timeout(err) ||
+		pgconn.SafeToRetry(err)
+}
+
+// PruneIngestedSamples 는 보관 기간이 지난 idempotency key 를 삭제합니다
+func PruneIngestedSamples() {
+	_, err := db.Pool.Exec(context.Background(), `
+		DELETE FROM ingested_samples WHERE received_at < $1
+	`, time.Now().UTC().Add(-ingestedSampleRetention))
+	if err != nil {
+		log.Println("Failed to prune ingested samples", "Error:", err)
+	}
+}
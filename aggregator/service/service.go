@@ -6,112 +6,799 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/aggregator/db"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/aggregator/kube"
 	sharedTypes "github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+	"github.com/jackc/pgx/v5"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// keyedMetric 은 중복 저장 여부를 판단하는 idempotency key 와 함께 전달된 샘플입니다
+type keyedMetric struct {
+	Key    string
+	Metric sharedTypes.Metric
+}
+
+// idempotencyKey 는 샘플의 기본 idempotency key 를 생성합니다
+// 같은 노드의 같은 시각 샘플은 pull 과 push 중 어느 경로로 들어와도 같은 키를 가집니다
+func idempotencyKey(m sharedTypes.Metric) string {
+	return m.NodeMetric.NodeName + "/" + m.Timestamp.UTC().Format(time.RFC3339Nano)
+}
+
 func SaveMetrics() {
 	log.Println("SaveMetrics() executed")
 
+	for _, c := range getCollectors() {
+		if err := pullMetrics(c); err != nil {
+			log.Println("Failed to save metrics from", c.IP, "Error:", err)
+		}
+	}
+}
+
+// storeMetrics 는 샘플을 데이터베이스에 저장합니다
+// 이미 같은 idempotency key 로 저장된 샘플은 건너뛰며, 실제로 저장한 샘플 수를 반환합니다
+// 저장에 실패한 샘플이 있으면 나머지 샘플을 계속 저장하고 마지막 에러를 함께 반환합니다
+func storeMetrics(metrics []keyedMetric) (int, error) {
 	podUIDToDeploymentNameMap, podUIDToNamespaceNameMap, podUIDToPodMap := getResourceInfo()
-	collectorIps := getCollectorIps()
-	metrics := fetchMetrics(collectorIps)
 
 	ctx := context.Background()
 
-	for _, m := range metrics {
-		_, err := db.Pool.Exec(ctx, `
-			INSERT INTO node_metrics (
+	stored := 0
+	var storeErr error
+	for _, km := range metrics {
+		claimed, err := storeMetric(ctx, km, podUIDToDeploymentNameMap, podUIDToNamespaceNameMap, podUIDToPodMap)
+		if err != nil {
+			log.Println("Failed to store metric with idempotency key", km.Key, "Error:", err)
+			storeErr = err
+			continue
+		}
+		if claimed {
+			stored++
+		}
+	}
+
+	return stored, storeErr
+}
+
+// storeMetric 은 idempotency key 기록과 샘플의 모든 행을 하나의 트랜잭션으로 저장합니다
+// 하나라도 저장에 실패하면 롤백하여 idempotency key 도 기록하지 않으므로, 재시도한 샘플이 중복으로 취급되지 않습니다
+// 이미 저장된 샘플이면 false 를 반환합니다
+func storeMetric(ctx context.Context, km keyedMetric, podUIDToDeploymentNameMap, podUIDToNamespaceNameMap map[types.UID]string, podUIDToPodMap map[types.UID]*v1.Pod) (bool, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	claimed, err := claimIdempotencyKey(ctx, tx, km.Key)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if !claimed {
+		return false, nil // 이미 저장된 샘플
+	}
+
+	m := km.Metric
+	if err := recordCollectErrors(ctx, tx, m); err != nil {
+		return false, err
+	}
+
+	// 일부 필드 묶음이 수집되지 않은 노드 메트릭도 저장하되, 0 으로 저장하면 속도 계산이 틀어지므로 해당 컬럼은 NULL 로 저장합니다
+	n := m.NodeMetric
+
+	// boot ID 등 시스템 정보를 수집하지 못한 경우 NULL 로 저장합니다
+	var bootIDParam, bootTimeParam, uptimeParam, load1Param, load5Param, load15Param any
+	var contextSwitchesParam, procsTotalParam, procsRunningParam, procsBlockedParam any
+	if n.IsValid(sharedTypes.FieldSystem) {
+		bootIDParam = n.BootID
+		bootTimeParam = time.Unix(int64(n.BootTime), 0).UTC()
+		uptimeParam = n.Uptime
+		load1Param, load5Param, load15Param = n.Load1, n.Load5, n.Load15
+		contextSwitchesParam = n.ContextSwitches
+		procsTotalParam, procsRunningParam, procsBlockedParam = n.ProcsTotal, n.ProcsRunning, n.ProcsBlocked
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO node_metrics (
+			timestamp,
+			node_name,
+			cpu_total,
+			cpu_busy,
+			cpu_count,
+			memory_total,
+			memory_available,
+			memory_used,
+			disk_read_bytes,
+			disk_write_bytes,
+			network_rx_bytes,
+			network_tx_bytes,
+			cpu_user_seconds,
+			cpu_nice_seconds,
+			cpu_system_seconds,
+			cpu_idle_seconds,
+			cpu_iowait_seconds,
+			cpu_irq_seconds,
+			cpu_softirq_seconds,
+			cpu_steal_seconds,
+			cpu_guest_seconds,
+			cpu_guest_nice_seconds,
+			memory_buffers,
+			memory_cached,
+			memory_dirty,
+			memory_slab_reclaimable,
+			memory_slab_unreclaimable,
+			swap_total,
+			swap_used,
+			hugepages_total,
+			hugepages_free,
+			hugepage_size,
+			boot_id,
+			boot_time,
+			uptime_seconds,
+			load1,
+			load5,
+			load15,
+			context_switches,
+			procs_total,
+			procs_running,
+			procs_blocked
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14, $15, $16, $17, $18, $19, $20, $21, $22,
+			$23, $24, $25, $26, $27, $28, $29, $30, $31, $32,
+			$33, $34, $35, $36, $37, $38, $39, $40, $41, $42
+		)
+	`,
+		m.Timestamp,
+		n.NodeName,
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUTotal),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUBusy),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUCount),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryTotal),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryAvailable),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryUsed),
+		nullable(n.IsValid(sharedTypes.FieldDisk), n.DiskReadBytes),
+		nullable(n.IsValid(sharedTypes.FieldDisk), n.DiskWriteBytes),
+		nullable(n.IsValid(sharedTypes.FieldNetwork), n.NetworkRxBytes),
+		nullable(n.IsValid(sharedTypes.FieldNetwork), n.NetworkTxBytes),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.User),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Nice),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.System),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Idle),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Iowait),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Irq),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Softirq),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Steal),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.Guest),
+		nullable(n.IsValid(sharedTypes.FieldCPU), n.CPUModes.GuestNice),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryBuffers),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryCached),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemoryDirty),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemorySlabReclaimable),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.MemorySlabUnreclaimable),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.SwapTotal),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.SwapUsed),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.HugePagesTotal),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.HugePagesFree),
+		nullable(n.IsValid(sharedTypes.FieldMemory), n.HugePageSize),
+		bootIDParam,
+		bootTimeParam,
+		uptimeParam,
+		load1Param,
+		load5Param,
+		load15Param,
+		contextSwitchesParam,
+		procsTotalParam,
+		procsRunningParam,
+		procsBlockedParam,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert node metric: %w", err)
+	}
+
+	for _, c := range m.NodeMetric.CPUs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO node_cpu_metrics (
 				timestamp,
 				node_name,
-				cpu_total,
-				cpu_busy,
-				cpu_count,
-				memory_total,
-				memory_available,
-				memory_used,
-				disk_read_bytes,
-				disk_write_bytes,
-				network_rx_bytes,
-				network_tx_bytes
+				cpu,
+				user_seconds,
+				nice_seconds,
+				system_seconds,
+				idle_seconds,
+				iowait_seconds,
+				irq_seconds,
+				softirq_seconds,
+				steal_seconds,
+				guest_seconds,
+				guest_nice_seconds
 			) VALUES (
-				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 			)
-		`,
-			m.Timestamp,
+		`, m.Timestamp,
 			m.NodeMetric.NodeName,
-			m.NodeMetric.CPUTotal,
-			m.NodeMetric.CPUBusy,
-			m.NodeMetric.CPUCount,
-			m.NodeMetric.MemoryTotal,
-			m.NodeMetric.MemoryAvailable,
-			m.NodeMetric.MemoryUsed,
-			m.NodeMetric.DiskReadBytes,
-			m.NodeMetric.DiskWriteBytes,
-			m.NodeMetric.NetworkRxBytes,
-			m.NodeMetric.NetworkTxBytes,
+			c.CPU,
+			c.User,
+			c.Nice,
+			c.System,
+			c.Idle,
+			c.Iowait,
+			c.Irq,
+			c.Softirq,
+			c.Steal,
+			c.Guest,
+			c.GuestNice,
 		)
 		if err != nil {
-			log.Println("Failed to insert node metric for node", m.NodeMetric.NodeName, "Error:", err)
+			return false, fmt.Errorf("failed to insert node cpu metric for cpu %s: %w", c.CPU, err)
 		}
+	}
 
-		for _, p := range m.PodMetric {
-			podName := podUIDToPodMap[types.UID(p.UID)].Name
-			deploymentName := podUIDToDeploymentNameMap[types.UID(p.UID)]
-			namespaceName := podUIDToNamespaceNameMap[types.UID(p.UID)]
-			nodeName := m.NodeMetric.NodeName
+	for _, d := range m.NodeMetric.Disks {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO node_disk_metrics (
+				timestamp,
+				node_name,
+				device,
+				read_bytes,
+				write_bytes,
+				read_count,
+				write_count,
+				merged_read_count,
+				merged_write_count,
+				read_time,
+				write_time,
+				io_time,
+				weighted_io_time
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+			)
+		`, m.Timestamp,
+			m.NodeMetric.NodeName,
+			d.Device,
+			d.ReadBytes,
+			d.WriteBytes,
+			d.ReadCount,
+			d.WriteCount,
+			d.MergedReadCount,
+			d.MergedWriteCount,
+			d.ReadTime,
+			d.WriteTime,
+			d.IoTime,
+			d.WeightedIoTime,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert node disk metric for device %s: %w", d.Device, err)
+		}
+	}
 
-			var namespaceParam any = namespaceName
-			if namespaceName == "" {
-				namespaceParam = nil
-			}
+	for _, i := range m.NodeMetric.Interfaces {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO node_interface_metrics (
+				timestamp,
+				node_name,
+				interface_name,
+				rx_bytes,
+				tx_bytes,
+				rx_packets,
+				tx_packets,
+				rx_errors,
+				tx_errors,
+				rx_dropped,
+				tx_dropped
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+			)
+		`, m.Timestamp,
+			m.NodeMetric.NodeName,
+			i.Name,
+			i.RxBytes,
+			i.TxBytes,
+			i.RxPackets,
+			i.TxPackets,
+			i.RxErrors,
+			i.TxErrors,
+			i.RxDropped,
+			i.TxDropped,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert node interface metric for interface %s: %w", i.Name, err)
+		}
+	}
+
+	for _, pr := range m.NodeMetric.Pressure {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO node_pressure_metrics (
+				timestamp,
+				node_name,
+				resource,
+				some_avg10,
+				some_avg60,
+				some_avg300,
+				some_total,
+				full_avg10,
+				full_avg60,
+				full_avg300,
+				full_total
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+			)
+		`, m.Timestamp,
+			m.NodeMetric.NodeName,
+			pr.Resource,
+			pr.SomeAvg10,
+			pr.SomeAvg60,
+			pr.SomeAvg300,
+			pr.SomeTotal,
+			pr.FullAvg10,
+			pr.FullAvg60,
+			pr.FullAvg300,
+			pr.FullTotal,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert node pressure metric for resource %s: %w", pr.Resource, err)
+		}
+	}
+
+	for _, f := range m.NodeMetric.Filesystems {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO node_filesystem_metrics (
+				timestamp,
+				node_name,
+				mountpoint,
+				device,
+				fs_type,
+				size_bytes,
+				used_bytes,
+				avail_bytes,
+				inodes_total,
+				inodes_used
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+			)
+		`, m.Timestamp,
+			m.NodeMetric.NodeName,
+			f.Mountpoint,
+			f.Device,
+			f.FsType,
+			f.SizeBytes,
+			f.UsedBytes,
+			f.AvailBytes,
+			f.InodesTotal,
+			f.InodesUsed,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert node filesystem metric for mountpoint %s: %w", f.Mountpoint, err)
+		}
+	}
 
-			var deploymentParam any = deploymentName
-			if deploymentName == "" {
-				deploymentParam = nil
+	for _, p := range m.PodMetric {
+		// informer 가 아직 파드를 보지 못했으면 수집기가 kubelet 에서 조회한 이름과 네임스페이스를 사용합니다
+		podName, namespaceName := p.Name, p.Namespace
+		if pod, ok := podUIDToPodMap[types.UID(p.UID)]; ok {
+			podName = pod.Name
+			namespaceName = podUIDToNamespaceNameMap[types.UID(p.UID)]
+		}
+		if podName == "" {
+			log.Println("Skipping pod metric with unknown pod name for pod UID", p.UID)
+			continue
+		}
+		deploymentName := podUIDToDeploymentNameMap[types.UID(p.UID)]
+		nodeName := m.NodeMetric.NodeName
+
+		var namespaceParam any = namespaceName
+		if namespaceName == "" {
+			namespaceParam = nil
+		}
+
+		var deploymentParam any = deploymentName
+		if deploymentName == "" {
+			deploymentParam = nil
+		}
+
+		// 카운터 필드 묶음이 수집되지 않은 경우 0 으로 저장하면 속도 계산이 틀어지므로 해당 컬럼은 NULL 로 저장합니다
+		cpuValid, memoryValid := p.IsValid(sharedTypes.FieldCPU), p.IsValid(sharedTypes.FieldMemory)
+		memoryEventsValid, diskValid, networkValid := p.IsValid(sharedTypes.FieldMemoryEvents), p.IsValid(sharedTypes.FieldDisk), p.IsValid(sharedTypes.FieldNetwork)
+
+		// 임시 스토리지 사용량을 수집하지 못한 경우 0 대신 NULL 로 저장합니다
+		var ephemeralEmptyDirParam, ephemeralWritableLayerParam any = p.EphemeralEmptyDirBytes, p.EphemeralWritableLayerBytes
+		if !p.IsValid(sharedTypes.FieldStorage) {
+			ephemeralEmptyDirParam, ephemeralWritableLayerParam = nil, nil
+		}
+
+		// 프로세스 수와 파일 디스크립터 수를 수집하지 못한 경우 NULL 로 저장합니다
+		// pids_max 의 0 은 제한 없음입니다
+		var pidsCurrentParam, pidsMaxParam, openFdsParam any = p.PidsCurrent, p.PidsMax, p.OpenFds
+		if !p.IsValid(sharedTypes.FieldPids) {
+			pidsCurrentParam, pidsMaxParam = nil, nil
+		}
+		if !p.IsValid(sharedTypes.FieldFds) {
+			openFdsParam = nil
+		}
+
+		// TCP 연결 통계를 수집하지 못한 경우 NULL 로 저장합니다
+		// hostNetwork 파드의 TCP 연결 통계는 노드 전체의 값이므로 저장하지 않습니다
+		var tcpEstablishedParam, tcpSynSentParam, tcpSynRecvParam, tcpFinWaitParam any = p.TCP.Established, p.TCP.SynSent, p.TCP.SynRecv, p.TCP.FinWait
+		var tcpTimeWaitParam, tcpCloseWaitParam, tcpLastAckParam, tcpListenParam any = p.TCP.TimeWait, p.TCP.CloseWait, p.TCP.LastAck, p.TCP.Listen
+		var tcpOutSegsParam, tcpRetransSegsParam, tcpListenOverflowsParam, tcpListenDropsParam any = p.TCP.OutSegs, p.TCP.RetransSegs, p.TCP.ListenOverflows, p.TCP.ListenDrops
+		if !p.IsValid(sharedTypes.FieldTCP) || p.HostNetwork {
+			tcpEstablishedParam, tcpSynSentParam, tcpSynRecvParam, tcpFinWaitParam = nil, nil, nil, nil
+			tcpTimeWaitParam, tcpCloseWaitParam, tcpLastAckParam, tcpListenParam = nil, nil, nil, nil
+			tcpOutSegsParam, tcpRetransSegsParam, tcpListenOverflowsParam, tcpListenDropsParam = nil, nil, nil, nil
+		}
+
+		// QoS 등급을 보내지 않는 이전 버전 수집기의 메트릭은 NULL 로 저장합니다
+		var qosClassParam any = p.QoSClass
+		if p.QoSClass == "" {
+			qosClassParam = nil
+		}
+
+		// CPU, 메모리 제한을 수집하지 못한 경우 NULL 로 저장합니다
+		// 0 은 제한 없음입니다
+		var cpuQuotaParam, cpuPeriodParam, cpuWeightParam any = p.CPUQuotaUsec, p.CPUPeriodUsec, p.CPUWeight
+		var memoryMaxParam, memoryHighParam any = p.MemoryMax, p.MemoryHigh
+		if !p.IsValid(sharedTypes.FieldLimits) {
+			cpuQuotaParam, cpuPeriodParam, cpuWeightParam = nil, nil, nil
+			memoryMaxParam, memoryHighParam = nil, nil
+		}
+
+		// 직전 수집 이후 증가한 메모리 이벤트 기록
+		if memoryEventsValid {
+			if err := recordMemoryEvents(ctx, tx, m.Timestamp, podName, p, namespaceParam, nodeName); err != nil {
+				return false, err
 			}
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO pod_metrics (
+				timestamp,
+				pod_name,
+				uid,
+				cpu_usage_usec,
+				memory_usage,
+				disk_read_bytes,
+				disk_write_bytes,
+				network_rx_bytes,
+				network_tx_bytes,
+				namespace_name,
+				deployment_name,
+				node_name,
+				memory_working_set,
+				memory_rss,
+				memory_cache,
+				memory_kernel,
+				memory_sock,
+				memory_swap,
+				cpu_nr_periods,
+				cpu_nr_throttled,
+				cpu_throttled_usec,
+				memory_high_events,
+				memory_max_events,
+				oom_events,
+				oom_kill_events,
+				ephemeral_empty_dir_bytes,
+				ephemeral_writable_layer_bytes,
+				pids_current,
+				pids_max,
+				open_fds,
+				disk_read_count,
+				disk_write_count,
+				tcp_established,
+				tcp_syn_sent,
+				tcp_syn_recv,
+				tcp_fin_wait,
+				tcp_time_wait,
+				tcp_close_wait,
+				tcp_last_ack,
+				tcp_listen,
+				tcp_out_segs,
+				tcp_retrans_segs,
+				tcp_listen_overflows,
+				tcp_listen_drops,
+				host_network,
+				qos_class,
+				cpu_quota_usec,
+				cpu_period_usec,
+				cpu_weight,
+				memory_max,
+				memory_high
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+				$13, $14, $15, $16, $17, $18, $19, $20, $21,
+				$22, $23, $24, $25, $26, $27, $28, $29, $30,
+				$31, $32, $33, $34, $35, $36, $37, $38, $39, $40,
+				$41, $42, $43, $44, $45, $46, $47, $48, $49, $50,
+				$51
+			)
+		`, m.Timestamp,
+			podName,
+			p.UID,
+			nullable(cpuValid, p.CPUUsageUsec),
+			nullable(memoryValid, p.MemoryUsage),
+			nullable(diskValid, p.DiskReadBytes),
+			nullable(diskValid, p.DiskWriteBytes),
+			nullable(networkValid, p.NetworkRxBytes),
+			nullable(networkValid, p.NetworkTxBytes),
+			namespaceParam,
+			deploymentParam,
+			nodeName,
+			nullable(memoryValid, p.MemoryWorkingSet),
+			nullable(memoryValid, p.MemoryRSS),
+			nullable(memoryValid, p.MemoryCache),
+			nullable(memoryValid, p.MemoryKernel),
+			nullable(memoryValid, p.MemorySock),
+			nullable(memoryValid, p.MemorySwap),
+			nullable(cpuValid, p.CPUNrPeriods),
+			nullable(cpuValid, p.CPUNrThrottled),
+			nullable(cpuValid, p.CPUThrottledUsec),
+			nullable(memoryEventsValid, p.MemoryHighEvents),
+			nullable(memoryEventsValid, p.MemoryMaxEvents),
+			nullable(memoryEventsValid, p.OomEvents),
+			nullable(memoryEventsValid, p.OomKillEvents),
+			ephemeralEmptyDirParam,
+			ephemeralWritableLayerParam,
+			pidsCurrentParam,
+			pidsMaxParam,
+			openFdsParam,
+			nullable(diskValid, p.DiskReadCount),
+			nullable(diskValid, p.DiskWriteCount),
+			tcpEstablishedParam,
+			tcpSynSentParam,
+			tcpSynRecvParam,
+			tcpFinWaitParam,
+			tcpTimeWaitParam,
+			tcpCloseWaitParam,
+			tcpLastAckParam,
+			tcpListenParam,
+			tcpOutSegsParam,
+			tcpRetransSegsParam,
+			tcpListenOverflowsParam,
+			tcpListenDropsParam,
+			p.HostNetwork,
+			qosClassParam,
+			cpuQuotaParam,
+			cpuPeriodParam,
+			cpuWeightParam,
+			memoryMaxParam,
+			memoryHighParam,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert pod metric for pod UID %s: %w", p.UID, err)
+		}
 
-			_, err := db.Pool.Exec(ctx, `
-				INSERT INTO pod_metrics (
+		for _, c := range p.Containers {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO container_metrics (
 					timestamp,
 					pod_name,
-					uid,
+					pod_uid,
+					container_id,
 					cpu_usage_usec,
 					memory_usage,
 					disk_read_bytes,
 					disk_write_bytes,
-					network_rx_bytes,
-					network_tx_bytes,
 					namespace_name,
-					deployment_name,
-					node_name
+					node_name,
+					cpu_nr_periods,
+					cpu_nr_throttled,
+					cpu_throttled_usec,
+					container_name,
+					image,
+					restart_count
+				) VALUES (
+					$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+					$14, $15, $16
+				)
+			`, m.Timestamp,
+				podName,
+				p.UID,
+				c.ID,
+				c.CPUUsageUsec,
+				c.MemoryUsage,
+				c.DiskReadBytes,
+				c.DiskWriteBytes,
+				namespaceParam,
+				nodeName,
+				c.CPUNrPeriods,
+				c.CPUNrThrottled,
+				c.CPUThrottledUsec,
+				c.Name,
+				c.Image,
+				c.RestartCount,
+			)
+			if err != nil {
+				return false, fmt.Errorf("failed to insert container metric for container ID %s: %w", c.ID, err)
+			}
+		}
+
+		for _, pr := range p.Pressure {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO pod_pressure_metrics (
+					timestamp,
+					pod_name,
+					pod_uid,
+					namespace_name,
+					node_name,
+					resource,
+					some_avg10,
+					some_avg60,
+					some_avg300,
+					some_total,
+					full_avg10,
+					full_avg60,
+					full_avg300,
+					full_total
+				) VALUES (
+					$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+				)
+			`, m.Timestamp,
+				podName,
+				p.UID,
+				namespaceParam,
+				nodeName,
+				pr.Resource,
+				pr.SomeAvg10,
+				pr.SomeAvg60,
+				pr.SomeAvg300,
+				pr.SomeTotal,
+				pr.FullAvg10,
+				pr.FullAvg60,
+				pr.FullAvg300,
+				pr.FullTotal,
+			)
+			if err != nil {
+				return false, fmt.Errorf("failed to insert pod pressure metric for pod UID %s resource %s: %w", p.UID, pr.Resource, err)
+			}
+		}
+
+		for _, d := range p.Disks {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO pod_disk_metrics (
+					timestamp,
+					pod_name,
+					pod_uid,
+					namespace_name,
+					node_name,
+					device,
+					major,
+					minor,
+					read_bytes,
+					write_bytes,
+					read_count,
+					write_count
 				) VALUES (
 					$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 				)
 			`, m.Timestamp,
 				podName,
 				p.UID,
-				p.CPUUsageUsec,
-				p.MemoryUsage,
-				p.DiskReadBytes,
-				p.DiskWriteBytes,
-				p.NetworkRxBytes,
-				p.NetworkTxBytes,
 				namespaceParam,
-				deploymentParam,
 				nodeName,
+				d.Device,
+				d.Major,
+				d.Minor,
+				d.ReadBytes,
+				d.WriteBytes,
+				d.ReadCount,
+				d.WriteCount,
+			)
+			if err != nil {
+				return false, fmt.Errorf("failed to insert pod disk metric for pod UID %s device %s: %w", p.UID, d.Device, err)
+			}
+		}
+
+		// hostNetwork 파드의 인터페이스는 노드의 인터페이스이므로 저장하지 않습니다
+		interfaces := p.Interfaces
+		if p.HostNetwork {
+			interfaces = nil
+		}
+		for _, i := range interfaces {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO pod_interface_metrics (
+					timestamp,
+					pod_name,
+					pod_uid,
+					namespace_name,
+					node_name,
+					interface_name,
+					rx_bytes,
+					tx_bytes,
+					rx_packets,
+					tx_packets,
+					rx_errors,
+					tx_errors,
+					rx_dropped,
+					tx_dropped
+				) VALUES (
+					$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+				)
+			`, m.Timestamp,
+				podName,
+				p.UID,
+				namespaceParam,
+				nodeName,
+				i.Name,
+				i.RxBytes,
+				i.TxBytes,
+				i.RxPackets,
+				i.TxPackets,
+				i.RxErrors,
+				i.TxErrors,
+				i.RxDropped,
+				i.TxDropped,
 			)
 			if err != nil {
-				log.Println("Failed to insert pod metric for pod UID", p.UID, "Error:", err)
-				continue
+				return false, fmt.Errorf("failed to insert pod interface metric for pod UID %s interface %s: %w", p.UID, i.Name, err)
 			}
 		}
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// recordCollectErrors 는 수집기가 보고한 수집 실패 목록을 저장합니다
+func recordCollectErrors(ctx context.Context, tx pgx.Tx, m sharedTypes.Metric) error {
+	for _, e := range m.Errors {
+		var podUIDParam, containerIDParam any
+		if e.PodUID != "" {
+			podUIDParam = e.PodUID
+		}
+		if e.ContainerID != "" {
+			containerIDParam = e.ContainerID
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO collector_errors (
+				timestamp,
+				node_name,
+				subsystem,
+				pod_uid,
+				container_id,
+				message
+			) VALUES (
+				$1, $2, $3, $4, $5, $6
+			)
+		`, m.Timestamp,
+			m.NodeMetric.NodeName,
+			e.Subsystem,
+			podUIDParam,
+			containerIDParam,
+			e.Message,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert collector error for subsystem %s: %w", e.Subsystem, err)
+		}
+	}
+	return nil
+}
+
+// nullable 은 필드 묶음이 수집되지 않았으면 0 대신 NULL 로 저장하도록 nil 을 반환합니다
+func nullable(valid bool, value any) any {
+	if !valid {
+		return nil
+	}
+	return value
+}
+
+// claimIdempotencyKey 는 idempotency key 를 기록하고, 처음 기록된 키이면 true 를 반환합니다
+func claimIdempotencyKey(ctx context.Context, tx pgx.Tx, key string) (bool, error) {
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO ingested_samples (idempotency_key, received_at)
+		VALUES ($1, $2)
+		ON CONFLICT (idempotency_key) DO NOTHING
+	`, key, time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
 }
 
 func getResourceInfo() (map[types.UID]string, map[types.UID]string, map[types.UID]*v1.Pod) {
@@ -155,43 +842,76 @@ func getResourceInfo() (map[types.UID]string, map[types.UID]string, map[types.UI
 	return podUIDToDeploymentNameMap, podUIDToNamespaceNameMap, podUIDToPodMap
 }
 
-func getCollectorIps() []string {
-	var ips []string
+// collector 는 메트릭을 가져올 수집기 파드의 주소와 수집기가 실행 중인 노드입니다
+type collector struct {
+	IP       string
+	NodeName string
+}
+
+func getCollectors() []collector {
+	var collectors []collector
 
 	selector := labels.SelectorFromSet(labels.Set{"kubernetes.io/service-name": "metrics-collector-headless-svc"})
 	endpointSlices, _ := kube.EndpointSliceLister.EndpointSlices("metrics-server-ns").List(selector)
 	for _, endpointSlice := range endpointSlices {
 		for _, endpoint := range endpointSlice.Endpoints {
 			if len(endpoint.Addresses) > 0 {
-				ips = append(ips, endpoint.Addresses[0])
+				c := collector{IP: endpoint.Addresses[0]}
+				if endpoint.NodeName != nil {
+					c.NodeName = *endpoint.NodeName
+				}
+				collectors = append(collectors, c)
 			}
 		}
 	}
 
-	return ips
+	return collectors
 }
 
-func fetchMetrics(ips []string) []sharedTypes.Metric {
-	var metrics []sharedTypes.Metric
-	for _, ip := range ips {
-		resp, err := http.Get(fmt.Sprintf("http://%s:9000/metrics", ip))
-		if err != nil {
-			log.Println("Failed to fetch metrics from", ip, "Error:", err)
-			continue
-		}
-		defer resp.Body.Close()
+// pullMetrics 는 수집기에서 마지막으로 저장한 샘플 이후의 샘플을 모두 가져와 저장합니다
+// 가져온 샘플을 모두 저장한 뒤에만 커서를 옮기므로, 짧은 장애로 놓치거나 저장에 실패한 샘플도
+// 수집기 버퍼에 남아 있는 동안에는 다음 실행에서 다시 가져옵니다 (이미 저장된 샘플은 idempotency key 로 건너뜁니다)
+func pullMetrics(c collector) error {
+	since := cursors.get(c.NodeName)
+	samples, err := fetchHistory(c.IP, since)
+	if err != nil {
+		return fmt.Errorf("failed to fetch metrics: %w", err)
+	}
 
-		if resp.StatusCode != http.StatusOK {
-			log.Println("Failed to fetch metrics from", ip, "Status Code:", resp.StatusCode)
-			continue
+	keyedMetrics := make([]keyedMetric, 0, len(samples))
+	for _, sample := range samples {
+		keyedMetrics = append(keyedMetrics, keyedMetric{Key: idempotencyKey(sample), Metric: sample})
+		if sample.Timestamp.After(since) {
+			since = sample.Timestamp
 		}
+	}
+	if _, err := storeMetrics(keyedMetrics); err != nil {
+		return fmt.Errorf("failed to store metrics: %w", err)
+	}
 
-		var metric sharedTypes.Metric
-		if err := json.NewDecoder(resp.Body).Decode(&metric); err != nil {
-			log.Println("Failed to decode metrics from", ip, "Error:", err)
-			continue
-		}
-		metrics = append(metrics, metric)
+	cursors.set(c.NodeName, since)
+	return nil
+}
+
+func fetchHistory(ip string, since time.Time) ([]sharedTypes.Metric, error) {
+	u := fmt.Sprintf("http://%s:9000/metrics/history", ip)
+	if !since.IsZero() {
+		u += "?since=" + url.QueryEscape(since.UTC().Format(time.RFC3339Nano))
+	}
+
+	resp, err := http.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var samples []sharedTypes.Metric
+	if err := json.NewDecoder(resp.Body).Decode(&samples); err != nil {
+		return nil, fmt.Errorf("failed to decode metrics: %w", err)
 	}
-	return metrics
+	return samples, nil
 }
@@ -3,6 +3,7 @@ package controller
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
 type DeploymentController interface {
@@ -22,9 +23,17 @@ func NewDeploymentController(deploymentService service.DeploymentService) Deploy
 }
 
 // GetDeploymentsByNamespaceName 는 특정 네임스페이스의 모든 디플로이먼트와 리소스 사용량을 제공합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *deploymentController) GetDeploymentsByNamespaceName(ctx *fiber.Ctx) error {
 	namespaceName := ctx.Params("namespaceName")
-	metrics, err := c.deploymentService.FindByNamespaceName(namespaceName)
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.deploymentService.FindByNamespaceName(namespaceName, memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -36,10 +45,18 @@ func (c *deploymentController) GetDeploymentsByNamespaceName(ctx *fiber.Ctx) err
 }
 
 // GetMetricsByDeploymentName 는 특정 디플로이먼트의 리소스 사용량을 제공합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *deploymentController) GetMetricsByDeploymentName(ctx *fiber.Ctx) error {
 	namespaceName := ctx.Params("namespaceName")
 	deploymentName := ctx.Params("deploymentName")
-	metrics, err := c.deploymentService.FindByDeploymentName(namespaceName, deploymentName)
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.deploymentService.FindByDeploymentName(namespaceName, deploymentName, memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -51,10 +68,18 @@ func (c *deploymentController) GetMetricsByDeploymentName(ctx *fiber.Ctx) error
 }
 
 // GetPodMetricsByDeploymentName 는 특정 디플로이먼트의 모든 파드 목록과 리소스 사용량을 제공합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *deploymentController) GetPodMetricsByDeploymentName(ctx *fiber.Ctx) error {
 	namespaceName := ctx.Params("namespaceName")
 	deploymentName := ctx.Params("deploymentName")
-	metrics, err := c.deploymentService.FindPodsByDeploymentName(namespaceName, deploymentName)
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.deploymentService.FindPodsByDeploymentName(namespaceName, deploymentName, memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+)
+
+type EventController interface {
+	GetOomEventsByNamespaceName(ctx *fiber.Ctx) error
+}
+
+type eventController struct {
+	eventService service.EventService
+}
+
+func NewEventController(eventService service.EventService) EventController {
+	return &eventController{
+		eventService: eventService,
+	}
+}
+
+// GetOomEventsByNamespaceName 은 특정 네임스페이스에서 발생한 OOM 이벤트 목록을 제공합니다.
+// window 쿼리 파라미터로 조회 구간을 지정할 수 있으며 기본값은 24h 입니다.
+func (c *eventController) GetOomEventsByNamespaceName(ctx *fiber.Ctx) error {
+	namespaceName := ctx.Params("namespaceName")
+	window := ctx.Query("window", "24h")
+
+	events, err := c.eventService.FindOomEventsByNamespaceName(namespaceName, window)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.JSON(events)
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
 type NamespaceController interface {
@@ -22,8 +23,16 @@ func NewNamespaceController(namespaceService service.NamespaceService) Namespace
 }
 
 // GetMetricsList 는 모든 네임스페이스의 집계된 메트릭을 제공합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *namespaceController) GetMetricsList(ctx *fiber.Ctx) error {
-	metrics, err := c.namespaceService.FindAll()
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.namespaceService.FindAll(memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -33,13 +42,21 @@ func (c *namespaceController) GetMetricsList(ctx *fiber.Ctx) error {
 
 // GetMetricsByNamespaceName 는 특정 네임스페이스의 집계된 메트릭을 제공합니다.
 // window 쿼리 파라미터가 있으면 시계열 조회, 없으면 실시간 조회를 수행합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *namespaceController) GetMetricsByNamespaceName(ctx *fiber.Ctx) error {
 	namespaceName := ctx.Params("namespaceName")
 	window := ctx.Query("window")
 
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	// window 파라미터가 있으면 시계열 조회
 	if window != "" {
-		timeSeriesMetrics, err := c.namespaceService.FindTimeSeriesByNamespaceName(namespaceName, window)
+		timeSeriesMetrics, err := c.namespaceService.FindTimeSeriesByNamespaceName(namespaceName, window, memory)
 		if err != nil {
 			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": err.Error(),
@@ -52,7 +69,7 @@ func (c *namespaceController) GetMetricsByNamespaceName(ctx *fiber.Ctx) error {
 	}
 
 	// window 파라미터가 없으면 기존 실시간 조회
-	metrics, err := c.namespaceService.FindByNamespaceName(namespaceName)
+	metrics, err := c.namespaceService.FindByNamespaceName(namespaceName, memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -64,9 +81,17 @@ func (c *namespaceController) GetMetricsByNamespaceName(ctx *fiber.Ctx) error {
 }
 
 // GetPodMetricsListByNamespaceName 는 특정 네임스페이스에 존재하는 모든 파드의 최신 메트릭을 조회합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *namespaceController) GetPodMetricsListByNamespaceName(ctx *fiber.Ctx) error {
 	namespaceName := ctx.Params("namespaceName")
-	metrics, err := c.namespaceService.FindPodsByNamespaceName(namespaceName)
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.namespaceService.FindPodsByNamespaceName(namespaceName, memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
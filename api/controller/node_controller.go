@@ -3,6 +3,7 @@ package controller
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
 type NodeController interface {
@@ -24,8 +25,16 @@ func NewNodeController(nodeService service.NodeService, podService service.PodSe
 }
 
 // GetMetricsList 는 모든 노드의 최신 메트릭을 제공합니다.
+// breakdown 쿼리 파라미터로 장치별, 인터페이스별, 논리 CPU 별 세부 메트릭을 함께 조회할 수 있습니다.
 func (c *nodeController) GetMetricsList(ctx *fiber.Ctx) error {
-	metrics, err := c.nodeService.FindAll()
+	breakdown, err := utils.ParseBreakdown(ctx.Query("breakdown"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.nodeService.FindAll(breakdown)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -35,12 +44,40 @@ func (c *nodeController) GetMetricsList(ctx *fiber.Ctx) error {
 
 // GetMetricsByNodeName 은 특정 노드의 최신 메트릭을 제공합니다.
 // window 쿼리 파라미터가 있으면 시계열 조회, 없으면 실시간 조회를 수행합니다.
+// breakdown 쿼리 파라미터로 장치별, 인터페이스별, 논리 CPU 별 세부 메트릭을 함께 조회할 수 있으며, window 와 함께 지정할 수 없습니다.
+// detail 쿼리 파라미터로 메모리 세부 항목을 함께 조회할 수 있으며, window 와 함께 지정할 수 없습니다.
 func (c *nodeController) GetMetricsByNodeName(ctx *fiber.Ctx) error {
 	nodeName := ctx.Params("nodeName")
 	window := ctx.Query("window")
 
+	breakdown, err := utils.ParseBreakdown(ctx.Query("breakdown"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	detail, err := utils.ParseDetail(ctx.Query("detail"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	// window 파라미터가 있으면 시계열 조회
 	if window != "" {
+		// 시계열은 노드 전체의 값만 계산하므로 breakdown 을 무시하지 않고 거부합니다
+		if ctx.Query("breakdown") != "" {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "breakdown is not supported with window",
+			})
+		}
+		if ctx.Query("detail") != "" {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "detail is not supported with window",
+			})
+		}
+
 		timeSeriesMetrics, err := c.nodeService.FindTimeSeriesByNodeName(nodeName, window)
 		if err != nil {
 			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -54,7 +91,7 @@ func (c *nodeController) GetMetricsByNodeName(ctx *fiber.Ctx) error {
 	}
 
 	// window 파라미터가 없으면 기존 실시간 조회
-	metrics, err := c.nodeService.FindByNodeName(nodeName)
+	metrics, err := c.nodeService.FindByNodeName(nodeName, breakdown, detail)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -66,9 +103,24 @@ func (c *nodeController) GetMetricsByNodeName(ctx *fiber.Ctx) error {
 }
 
 // GetPodMetricsListByNodeName 은 특정 노드에 존재하는 모든 파드의 최신 메트릭을 조회합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
+// qos 쿼리 파라미터로 QoS 등급(guaranteed, burstable, besteffort)별 파드만 조회할 수 있습니다.
 func (c *nodeController) GetPodMetricsListByNodeName(ctx *fiber.Ctx) error {
 	nodeName := ctx.Params("nodeName")
-	metrics, err := c.podService.FindByNodeName(nodeName)
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	qos, err := utils.ParseQoSClass(ctx.Query("qos"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.podService.FindByNodeName(nodeName, memory, qos)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -3,26 +3,39 @@ package controller
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
 type PodController interface {
 	GetMetricsList(ctx *fiber.Ctx) error
 	GetMetricsByPodName(ctx *fiber.Ctx) error
+	GetContainerMetricsByPodName(ctx *fiber.Ctx) error
+	GetThrottledPods(ctx *fiber.Ctx) error
 }
 
 type podController struct {
-	podService service.PodService
+	podService       service.PodService
+	containerService service.ContainerService
 }
 
-func NewPodController(podService service.PodService) PodController {
+func NewPodController(podService service.PodService, containerService service.ContainerService) PodController {
 	return &podController{
-		podService: podService,
+		podService:       podService,
+		containerService: containerService,
 	}
 }
 
 // GetMetricsList 는 모든 파드의 최신 메트릭을 제공합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
 func (c *podController) GetMetricsList(ctx *fiber.Ctx) error {
-	metrics, err := c.podService.FindAll()
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.podService.FindAll(memory)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -32,13 +45,42 @@ func (c *podController) GetMetricsList(ctx *fiber.Ctx) error {
 
 // GetMetricsByPodName 는 특정 파드의 최신 메트릭을 제공합니다.
 // window 쿼리 파라미터가 있으면 시계열 조회, 없으면 실시간 조회를 수행합니다.
+// memory 쿼리 파라미터로 메모리 사용량 기준(usage, working_set, rss)을 선택할 수 있습니다.
+// breakdown 쿼리 파라미터로 장치별 디스크 I/O(device), 인터페이스별 네트워크 메트릭(interface)을 함께 조회할 수 있으며, window 와 함께 지정할 수 없습니다.
 func (c *podController) GetMetricsByPodName(ctx *fiber.Ctx) error {
 	podName := ctx.Params("podName")
 	window := ctx.Query("window")
 
+	memory, err := utils.ParseMemoryFlavor(ctx.Query("memory"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	breakdown, err := utils.ParseBreakdown(ctx.Query("breakdown"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	// 파드에는 논리 CPU 별 메트릭이 없으므로 cpu 를 무시하지 않고 거부합니다
+	if breakdown.CPU {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "unsupported breakdown for pods: cpu (expected one of: device, interface)",
+		})
+	}
+
 	// window 파라미터가 있으면 시계열 조회
 	if window != "" {
-		timeSeriesMetrics, err := c.podService.FindTimeSeriesByPodName(podName, window)
+		// 시계열은 파드 전체의 값만 계산하므로 breakdown 을 무시하지 않고 거부합니다
+		if ctx.Query("breakdown") != "" {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "breakdown is not supported with window",
+			})
+		}
+
+		timeSeriesMetrics, err := c.podService.FindTimeSeriesByPodName(podName, window, memory)
 		if err != nil {
 			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": err.Error(),
@@ -51,7 +93,7 @@ func (c *podController) GetMetricsByPodName(ctx *fiber.Ctx) error {
 	}
 
 	// window 파라미터가 없으면 기존 실시간 조회
-	metrics, err := c.podService.FindByPodName(podName)
+	metrics, err := c.podService.FindByPodName(podName, memory, breakdown)
 	if err != nil {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -61,3 +103,39 @@ func (c *podController) GetMetricsByPodName(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(metrics)
 }
+
+// GetContainerMetricsByPodName 은 특정 파드에 속한 컨테이너별 최신 메트릭을 제공합니다.
+func (c *podController) GetContainerMetricsByPodName(ctx *fiber.Ctx) error {
+	podName := ctx.Params("podName")
+	metrics, err := c.containerService.FindByPodName(podName)
+	if err != nil {
+		return ctx.SendStatus(fiber.StatusInternalServerError)
+	}
+	if metrics == nil {
+		return ctx.SendStatus(fiber.StatusNotFound)
+	}
+
+	return ctx.JSON(metrics)
+}
+
+// GetThrottledPods 는 주어진 윈도우 동안 CPU 스로틀링 비율이 임계값을 초과한 파드 목록을 제공합니다.
+// threshold 쿼리 파라미터는 0과 1 사이의 비율이며(기본값 0.1), window 쿼리 파라미터의 기본값은 5m 입니다.
+func (c *podController) GetThrottledPods(ctx *fiber.Ctx) error {
+	window := ctx.Query("window", "5m")
+
+	threshold, err := utils.ParseThreshold(ctx.Query("threshold"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	metrics, err := c.podService.FindThrottled(threshold, window)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.JSON(metrics)
+}
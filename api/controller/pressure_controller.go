@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/service"
+)
+
+type PressureController interface {
+	GetPressureByNodeName(ctx *fiber.Ctx) error
+	GetPressureByPodName(ctx *fiber.Ctx) error
+}
+
+type pressureController struct {
+	pressureService service.PressureService
+}
+
+func NewPressureController(pressureService service.PressureService) PressureController {
+	return &pressureController{
+		pressureService: pressureService,
+	}
+}
+
+// GetPressureByNodeName 은 특정 노드의 자원별 PSI 메트릭을 제공합니다.
+// window 쿼리 파라미터가 있으면 시계열 조회, 없으면 실시간 조회를 수행합니다.
+func (c *pressureController) GetPressureByNodeName(ctx *fiber.Ctx) error {
+	nodeName := ctx.Params("nodeName")
+	window := ctx.Query("window")
+
+	// window 파라미터가 있으면 시계열 조회
+	if window != "" {
+		timeSeriesMetrics, err := c.pressureService.FindTimeSeriesByNodeName(nodeName, window)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		if timeSeriesMetrics == nil {
+			return ctx.SendStatus(fiber.StatusNotFound)
+		}
+		return ctx.JSON(timeSeriesMetrics)
+	}
+
+	// window 파라미터가 없으면 실시간 조회
+	metrics, err := c.pressureService.FindByNodeName(nodeName)
+	if err != nil {
+		return ctx.SendStatus(fiber.StatusInternalServerError)
+	}
+	if metrics == nil {
+		return ctx.SendStatus(fiber.StatusNotFound)
+	}
+
+	return ctx.JSON(metrics)
+}
+
+// GetPressureByPodName 은 특정 파드의 자원별 PSI 메트릭을 제공합니다.
+// window 쿼리 파라미터가 있으면 시계열 조회, 없으면 실시간 조회를 수행합니다.
+func (c *pressureController) GetPressureByPodName(ctx *fiber.Ctx) error {
+	podName := ctx.Params("podName")
+	window := ctx.Query("window")
+
+	// window 파라미터가 있으면 시계열 조회
+	if window != "" {
+		timeSeriesMetrics, err := c.pressureService.FindTimeSeriesByPodName(podName, window)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		if timeSeriesMetrics == nil {
+			return ctx.SendStatus(fiber.StatusNotFound)
+		}
+		return ctx.JSON(timeSeriesMetrics)
+	}
+
+	// window 파라미터가 없으면 실시간 조회
+	metrics, err := c.pressureService.FindByPodName(podName)
+	if err != nil {
+		return ctx.SendStatus(fiber.StatusInternalServerError)
+	}
+	if metrics == nil {
+		return ctx.SendStatus(fiber.StatusNotFound)
+	}
+
+	return ctx.JSON(metrics)
+}
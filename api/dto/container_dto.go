@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type ContainerMetricsResponse struct {
+	Timestamp        time.Time `json:"timestamp"`
+	ContainerID      string    `json:"container_id"`
+	ContainerName    string    `json:"container_name"`
+	Image            string    `json:"image"`
+	RestartCount     int64     `json:"restart_count"`
+	PodName          string    `json:"pod_name"`
+	PodUID           string    `json:"pod_uid"`
+	NamespaceName    string    `json:"namespace_name"`
+	NodeName         string    `json:"node_name"`
+	CpuMillicores    float64   `json:"cpu_millicores"`
+	CpuThrottleRatio float64   `json:"cpu_throttle_ratio"` // nr_throttled / nr_periods
+	MemoryBytes      int64     `json:"memory_bytes"`
+	DiskReadBytes    int64     `json:"disk_read_bytes"`
+	DiskWriteBytes   int64     `json:"disk_write_bytes"`
+}
@@ -3,14 +3,18 @@ package dto
 import "time"
 
 type DeploymentMetricsResponse struct {
-	DeploymentName string    `json:"deployment_name"`
-	NamespaceName  string    `json:"namespace_name"`
-	Timestamp      time.Time `json:"timestamp"`
-	CpuMillicores  float64   `json:"cpu_millicores"`
-	MemoryBytes    int64     `json:"memory_bytes"`
-	DiskReadBytes  int64     `json:"disk_read_bytes"`
-	DiskWriteBytes int64     `json:"disk_write_bytes"`
-	NetworkRxBytes int64     `json:"network_rx_bytes"`
-	NetworkTxBytes int64     `json:"network_tx_bytes"`
-	PodCount       int       `json:"pod_count"`
+	DeploymentName   string    `json:"deployment_name"`
+	NamespaceName    string    `json:"namespace_name"`
+	Timestamp        time.Time `json:"timestamp"`
+	CpuMillicores    float64   `json:"cpu_millicores"`
+	CpuThrottleRatio float64   `json:"cpu_throttle_ratio"` // nr_throttled / nr_periods
+	MemoryBytes      int64     `json:"memory_bytes"`
+	DiskReadBytes    int64     `json:"disk_read_bytes"`
+	DiskWriteBytes   int64     `json:"disk_write_bytes"`
+	NetworkRxBytes   int64     `json:"network_rx_bytes"` // hostNetwork 파드 제외
+	NetworkTxBytes   int64     `json:"network_tx_bytes"` // hostNetwork 파드 제외
+	Pids             int64     `json:"pids"`             // 파드 pids.current 합계
+	OpenFds          int64     `json:"open_fds"`         // 파드 열린 파일 디스크립터 수 합계
+	PodCount         int       `json:"pod_count"`
+	HostNetworkPods  int       `json:"host_network_pod_count"`
 }
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+// PodEventResponse 는 수집 주기 사이에 증가한 파드 메모리 이벤트입니다.
+type PodEventResponse struct {
+	Timestamp     time.Time `json:"timestamp"`
+	PodName       string    `json:"pod_name"`
+	NamespaceName string    `json:"namespace_name"`
+	NodeName      string    `json:"node_name"`
+	UID           string    `json:"uid"`
+	EventType     string    `json:"event_type"` // oom, oom_kill
+	Count         int64     `json:"count"`      // 직전 수집 이후 증가한 횟수
+}
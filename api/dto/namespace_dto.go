@@ -3,15 +3,17 @@ package dto
 import "time"
 
 type NamespaceMetrics struct {
-	NamespaceName  string    `db:"namespace_name" json:"namespace_name"`
-	Timestamp      time.Time `db:"timestamp" json:"timestamp"`
-	CpuMillicores  float64   `db:"cpu_millicores" json:"cpu_millicores"`
-	MemoryBytes    int64     `db:"memory_bytes" json:"memory_bytes"`
-	DiskReadBytes  int64     `db:"disk_read_bytes" json:"disk_read_bytes"`
-	DiskWriteBytes int64     `db:"disk_write_bytes" json:"disk_write_bytes"`
-	NetworkRxBytes int64     `db:"network_rx_bytes" json:"network_rx_bytes"`
-	NetworkTxBytes int64     `db:"network_tx_bytes" json:"network_tx_bytes"`
-	PodCount       int       `db:"pod_count" json:"pod_count"`
+	NamespaceName    string    `db:"namespace_name" json:"namespace_name"`
+	Timestamp        time.Time `db:"timestamp" json:"timestamp"`
+	CpuMillicores    float64   `db:"cpu_millicores" json:"cpu_millicores"`
+	CpuThrottleRatio float64   `db:"cpu_throttle_ratio" json:"cpu_throttle_ratio"` // nr_throttled / nr_periods
+	MemoryBytes      int64     `db:"memory_bytes" json:"memory_bytes"`
+	DiskReadBytes    int64     `db:"disk_read_bytes" json:"disk_read_bytes"`
+	DiskWriteBytes   int64     `db:"disk_write_bytes" json:"disk_write_bytes"`
+	NetworkRxBytes   int64     `db:"network_rx_bytes" json:"network_rx_bytes"` // hostNetwork 파드 제외
+	NetworkTxBytes   int64     `db:"network_tx_bytes" json:"network_tx_bytes"` // hostNetwork 파드 제외
+	PodCount         int       `db:"pod_count" json:"pod_count"`
+	HostNetworkPods  int       `db:"host_network_pod_count" json:"host_network_pod_count"`
 }
 
 // NamespaceMetricsResponse 는 NamespaceMetrics의 별칭입니다.
@@ -29,5 +31,5 @@ type NamespaceTimeSeriesResponse struct {
 	AvgDiskReadRate  float64   `json:"avg_disk_read_rate"`  // bytes/sec
 	AvgDiskWriteRate float64   `json:"avg_disk_write_rate"` // bytes/sec
 	AvgNetworkRxRate float64   `json:"avg_network_rx_rate"` // bytes/sec
-	AvgNetworkTxRate float64   `json:"avg_network_tx_rate"` // bytes/sec
+	AvgNetworkTxRate float64   `json:"avg_network_tx_rate"` // bytes/sec, hostNetwork 파드 제외
 }
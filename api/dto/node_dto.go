@@ -5,14 +5,135 @@ import (
 )
 
 type NodeMetricsResponse struct {
-	Timestamp      time.Time `json:"timestamp"`
-	NodeName       string    `json:"node_name"`
-	CpuMillicores  float64   `json:"cpu_millicores"`
-	MemoryBytes    int64     `json:"memory_bytes"`
-	DiskReadBytes  int64     `json:"disk_read_bytes"`
-	DiskWriteBytes int64     `json:"disk_write_bytes"`
-	NetworkRxBytes int64     `json:"network_rx_bytes"`
-	NetworkTxBytes int64     `json:"network_tx_bytes"`
+	Timestamp      time.Time                        `json:"timestamp"`
+	NodeName       string                           `json:"node_name"`
+	CpuMillicores  float64                          `json:"cpu_millicores"`
+	CpuModes       *NodeCpuModesResponse            `json:"cpu_modes"` // 이전 메트릭에 모드별 시간이 없으면 null
+	System         *NodeSystemResponse              `json:"system"`    // 시스템 정보가 없으면 null
+	MemoryBytes    int64                            `json:"memory_bytes"`
+	Memory         *NodeMemoryResponse              `json:"memory,omitempty"` // detail=memory 일 때만 제공
+	DiskReadBytes  int64                            `json:"disk_read_bytes"`
+	DiskWriteBytes int64                            `json:"disk_write_bytes"`
+	NetworkRxBytes int64                            `json:"network_rx_bytes"`
+	NetworkTxBytes int64                            `json:"network_tx_bytes"`
+	DiskFullSoon   bool                             `json:"disk_full_soon"` // 파일시스템 중 하나라도 곧 가득 찰 예정
+	Filesystems    []*NodeFilesystemMetricsResponse `json:"filesystems"`
+	Disks          []*NodeDiskMetricsResponse       `json:"disks,omitempty"`
+	Interfaces     []*NodeInterfaceMetricsResponse  `json:"interfaces,omitempty"`
+	Cpus           []*NodeCpuCoreResponse           `json:"cpus,omitempty"`
+}
+
+// NodeSystemResponse 는 노드의 부팅 정보, 부하 평균과 프로세스 통계입니다.
+type NodeSystemResponse struct {
+	BootID              string     `json:"boot_id"`
+	BootTime            *time.Time `json:"boot_time,omitempty"`
+	UptimeSeconds       int64      `json:"uptime_seconds"`
+	Rebooted            bool       `json:"rebooted"` // 직전 수집 이후 재부팅되어 누적 카운터가 초기화됨
+	Load1               float64    `json:"load1"`
+	Load5               float64    `json:"load5"`
+	Load15              float64    `json:"load15"`
+	ContextSwitchesRate float64    `json:"context_switches_rate"` // switches/sec
+	ProcsTotal          int64      `json:"procs_total"`
+	ProcsRunning        int64      `json:"procs_running"`
+	ProcsBlocked        int64      `json:"procs_blocked"` // I/O 대기 중인 프로세스
+}
+
+// NodeMemoryResponse 는 노드의 /proc/meminfo 세부 항목입니다.
+type NodeMemoryResponse struct {
+	TotalBytes             int64   `json:"total_bytes"`
+	AvailableBytes         int64   `json:"available_bytes"`
+	UsedBytes              int64   `json:"used_bytes"`
+	BuffersBytes           int64   `json:"buffers_bytes"`
+	CachedBytes            int64   `json:"cached_bytes"`
+	DirtyBytes             int64   `json:"dirty_bytes"` // 디스크에 기록 대기 중인 페이지
+	SlabReclaimableBytes   int64   `json:"slab_reclaimable_bytes"`
+	SlabUnreclaimableBytes int64   `json:"slab_unreclaimable_bytes"`
+	SwapTotalBytes         int64   `json:"swap_total_bytes"`
+	SwapUsedBytes          int64   `json:"swap_used_bytes"`
+	SwapUsedPercent        float64 `json:"swap_used_percent"`
+	HugePagesTotal         int64   `json:"hugepages_total"` // 페이지 수
+	HugePagesFree          int64   `json:"hugepages_free"`  // 페이지 수
+	HugePageSizeBytes      int64   `json:"hugepage_size_bytes"`
+	HugePagesTotalBytes    int64   `json:"hugepages_total_bytes"` // hugepages_total * hugepage_size
+}
+
+// NodeCpuModesResponse 는 직전 수집 이후 CPU 시간 중 각 모드가 차지한 백분율입니다.
+// guest, guest_nice 는 user, nice 에 포함되어 있으므로 합계에 더하지 않습니다.
+type NodeCpuModesResponse struct {
+	UserPercent      float64 `json:"user_percent"`
+	NicePercent      float64 `json:"nice_percent"`
+	SystemPercent    float64 `json:"system_percent"`
+	IdlePercent      float64 `json:"idle_percent"`
+	IowaitPercent    float64 `json:"iowait_percent"`
+	IrqPercent       float64 `json:"irq_percent"`
+	SoftirqPercent   float64 `json:"softirq_percent"`
+	StealPercent     float64 `json:"steal_percent"` // 하이퍼바이저가 다른 VM 에 할당한 시간
+	GuestPercent     float64 `json:"guest_percent"`
+	GuestNicePercent float64 `json:"guest_nice_percent"`
+}
+
+// NodeCpuCoreResponse 는 노드의 논리 CPU 별 사용률입니다.
+type NodeCpuCoreResponse struct {
+	CPU                string  `json:"cpu"`
+	UtilizationPercent float64 `json:"utilization_percent"` // idle, iowait, steal 을 제외한 시간의 백분율
+	UserPercent        float64 `json:"user_percent"`
+	SystemPercent      float64 `json:"system_percent"`
+	IowaitPercent      float64 `json:"iowait_percent"`
+	StealPercent       float64 `json:"steal_percent"`
+}
+
+// NodeFilesystemMetricsResponse 는 노드의 마운트 경로별 파일시스템 용량 메트릭입니다.
+type NodeFilesystemMetricsResponse struct {
+	Mountpoint        string   `json:"mountpoint"`
+	Device            string   `json:"device"`
+	FsType            string   `json:"fs_type"`
+	SizeBytes         int64    `json:"size_bytes"`
+	UsedBytes         int64    `json:"used_bytes"`
+	AvailBytes        int64    `json:"avail_bytes"`
+	UsedPercent       float64  `json:"used_percent"`
+	InodesTotal       int64    `json:"inodes_total"`
+	InodesUsed        int64    `json:"inodes_used"`
+	InodesUsedPercent float64  `json:"inodes_used_percent"`
+	GrowthRate        float64  `json:"growth_rate"`                // bytes/sec
+	HoursUntilFull    *float64 `json:"hours_until_full,omitempty"` // 사용량이 증가 중일 때만 제공
+	DiskFullSoon      bool     `json:"disk_full_soon"`
+}
+
+// NodeDiskMetricsResponse 는 노드의 블록 장치별 디스크 메트릭입니다.
+type NodeDiskMetricsResponse struct {
+	Device              string  `json:"device"`
+	DiskReadBytes       int64   `json:"disk_read_bytes"`
+	DiskWriteBytes      int64   `json:"disk_write_bytes"`
+	DiskReadCount       int64   `json:"disk_read_count"`
+	DiskWriteCount      int64   `json:"disk_write_count"`
+	DiskIoTimeMs        int64   `json:"disk_io_time_ms"`
+	DiskReadRate        float64 `json:"disk_read_rate"`         // bytes/sec
+	DiskWriteRate       float64 `json:"disk_write_rate"`        // bytes/sec
+	DiskReadIops        float64 `json:"disk_read_iops"`         // ops/sec
+	DiskWriteIops       float64 `json:"disk_write_iops"`        // ops/sec
+	DiskReadMergedRate  float64 `json:"disk_read_merged_rate"`  // ops/sec
+	DiskWriteMergedRate float64 `json:"disk_write_merged_rate"` // ops/sec
+	DiskReadLatencyMs   float64 `json:"disk_read_latency_ms"`   // 읽기 요청당 평균 처리 시간
+	DiskWriteLatencyMs  float64 `json:"disk_write_latency_ms"`  // 쓰기 요청당 평균 처리 시간
+	DiskUtilPercent     float64 `json:"disk_util_percent"`      // 장치가 I/O 를 처리 중이던 시간 비율
+	DiskQueueDepth      float64 `json:"disk_queue_depth"`       // 평균 처리 중 요청 수
+}
+
+// NodeInterfaceMetricsResponse 는 노드의 네트워크 인터페이스별 메트릭입니다.
+type NodeInterfaceMetricsResponse struct {
+	InterfaceName     string  `json:"interface_name"`
+	NetworkRxBytes    int64   `json:"network_rx_bytes"`
+	NetworkTxBytes    int64   `json:"network_tx_bytes"`
+	NetworkRxPackets  int64   `json:"network_rx_packets"`
+	NetworkTxPackets  int64   `json:"network_tx_packets"`
+	NetworkRxErrors   int64   `json:"network_rx_errors"`
+	NetworkTxErrors   int64   `json:"network_tx_errors"`
+	NetworkRxDropped  int64   `json:"network_rx_dropped"`
+	NetworkTxDropped  int64   `json:"network_tx_dropped"`
+	NetworkRxRate     float64 `json:"network_rx_rate"`      // bytes/sec
+	NetworkTxRate     float64 `json:"network_tx_rate"`      // bytes/sec
+	NetworkRxPktsRate float64 `json:"network_rx_pkts_rate"` // packets/sec
+	NetworkTxPktsRate float64 `json:"network_tx_pkts_rate"` // packets/sec
 }
 
 // NodeTimeSeriesResponse 는 Node 시계열 조회 API의 응답 구조체입니다.
@@ -28,4 +149,22 @@ type NodeTimeSeriesResponse struct {
 	AvgDiskWriteRate float64   `json:"avg_disk_write_rate"` // bytes/sec
 	AvgNetworkRxRate float64   `json:"avg_network_rx_rate"` // bytes/sec
 	AvgNetworkTxRate float64   `json:"avg_network_tx_rate"` // bytes/sec
+
+	Disks []*NodeDiskTimeSeriesResponse `json:"disks"`
+}
+
+// NodeDiskTimeSeriesResponse 는 지정된 시간 구간 동안의 블록 장치별 디스크 I/O 요약입니다.
+// 처리량(bytes)과 함께 IOPS, 지연 시간, 사용률을 제공하여 장치의 포화 여부를 판단할 수 있습니다.
+type NodeDiskTimeSeriesResponse struct {
+	Device             string  `json:"device"`
+	AvgReadRate        float64 `json:"avg_read_rate"`         // bytes/sec
+	AvgWriteRate       float64 `json:"avg_write_rate"`        // bytes/sec
+	AvgReadIops        float64 `json:"avg_read_iops"`         // ops/sec
+	AvgWriteIops       float64 `json:"avg_write_iops"`        // ops/sec
+	AvgReadMergedRate  float64 `json:"avg_read_merged_rate"`  // ops/sec
+	AvgWriteMergedRate float64 `json:"avg_write_merged_rate"` // ops/sec
+	AvgReadLatencyMs   float64 `json:"avg_read_latency_ms"`
+	AvgWriteLatencyMs  float64 `json:"avg_write_latency_ms"`
+	AvgUtilPercent     float64 `json:"avg_util_percent"`
+	AvgQueueDepth      float64 `json:"avg_queue_depth"`
 }
@@ -5,18 +5,137 @@ import (
 )
 
 type PodMetricsResponse struct {
-	Timestamp      time.Time `json:"timestamp"`
-	PodName        string    `json:"pod_name"`
-	DeploymentName *string   `json:"deployment_name,omitempty"`
-	NamespaceName  string    `json:"namespace_name"`
-	NodeName       string    `json:"node_name"`
-	UID            string    `json:"uid"`
-	CpuMillicores  float64   `json:"cpu_millicores"`
-	MemoryBytes    int64     `json:"memory_bytes"`
-	DiskReadBytes  int64     `json:"disk_read_bytes"`
-	DiskWriteBytes int64     `json:"disk_write_bytes"`
-	NetworkRxBytes int64     `json:"network_rx_bytes"`
-	NetworkTxBytes int64     `json:"network_tx_bytes"`
+	Timestamp        time.Time                      `json:"timestamp"`
+	PodName          string                         `json:"pod_name"`
+	DeploymentName   *string                        `json:"deployment_name,omitempty"`
+	NamespaceName    string                         `json:"namespace_name"`
+	NodeName         string                         `json:"node_name"`
+	UID              string                         `json:"uid"`
+	QoSClass         *string                        `json:"qos_class"` // Guaranteed, Burstable, BestEffort, 알 수 없으면 null
+	CpuMillicores    float64                        `json:"cpu_millicores"`
+	CpuThrottleRatio float64                        `json:"cpu_throttle_ratio"` // nr_throttled / nr_periods
+	MemoryBytes      int64                          `json:"memory_bytes"`       // memory 파라미터로 선택된 기준 (기본값 usage)
+	Memory           *PodMemoryResponse             `json:"memory"`
+	OomKills         int64                          `json:"oom_kills"` // 파드 생성 이후 누적 OOM kill 횟수
+	Limits           *PodLimitsResponse             `json:"limits"`
+	EphemeralStorage *PodEphemeralStorageResponse   `json:"ephemeral_storage"`
+	Processes        *PodProcessResponse            `json:"processes"`
+	TCP              *PodTCPResponse                `json:"tcp"`
+	DiskReadBytes    int64                          `json:"disk_read_bytes"`
+	DiskWriteBytes   int64                          `json:"disk_write_bytes"`
+	DiskReadIops     float64                        `json:"disk_read_iops"`   // ops/sec
+	DiskWriteIops    float64                        `json:"disk_write_iops"`  // ops/sec
+	NetworkRxBytes   int64                          `json:"network_rx_bytes"` // 루프백 제외
+	NetworkTxBytes   int64                          `json:"network_tx_bytes"` // 루프백 제외
+	HostNetwork      bool                           `json:"host_network"`     // true 이면 network_* 는 노드 전체의 값
+	Disks            []*PodDiskMetricsResponse      `json:"disks,omitempty"`
+	Interfaces       []*PodInterfaceMetricsResponse `json:"interfaces,omitempty"` // 루프백 포함
+}
+
+// PodDiskMetricsResponse 는 파드가 블록 장치별로 수행한 I/O 입니다.
+type PodDiskMetricsResponse struct {
+	Device         string  `json:"device"`
+	DiskReadBytes  int64   `json:"disk_read_bytes"`
+	DiskWriteBytes int64   `json:"disk_write_bytes"`
+	DiskReadCount  int64   `json:"disk_read_count"`
+	DiskWriteCount int64   `json:"disk_write_count"`
+	DiskReadRate   float64 `json:"disk_read_rate"`  // bytes/sec
+	DiskWriteRate  float64 `json:"disk_write_rate"` // bytes/sec
+	DiskReadIops   float64 `json:"disk_read_iops"`  // ops/sec
+	DiskWriteIops  float64 `json:"disk_write_iops"` // ops/sec
+}
+
+// PodMemoryResponse 는 파드 cgroup 의 memory.stat 세부 항목입니다.
+type PodMemoryResponse struct {
+	UsageBytes      int64 `json:"usage_bytes"`       // memory.current (페이지 캐시 포함)
+	WorkingSetBytes int64 `json:"working_set_bytes"` // usage - inactive_file
+	RSSBytes        int64 `json:"rss_bytes"`         // anon
+	CacheBytes      int64 `json:"cache_bytes"`       // file
+	KernelBytes     int64 `json:"kernel_bytes"`      // kernel_stack + slab
+	SockBytes       int64 `json:"sock_bytes"`
+	SwapBytes       int64 `json:"swap_bytes"`
+}
+
+// PodLimitsResponse 는 파드 cgroup 에 설정된 CPU, 메모리 제한과 제한 대비 사용률입니다.
+// 제한이 없는 항목은 null 입니다.
+type PodLimitsResponse struct {
+	CpuLimitMillicores   *float64 `json:"cpu_limit_millicores"`   // cpu.max quota / period
+	CpuLimitPercent      *float64 `json:"cpu_limit_percent"`      // cpu_millicores / cpu_limit_millicores
+	CpuWeight            int64    `json:"cpu_weight"`             // cpu.weight (1~10000)
+	CpuRequestMillicores float64  `json:"cpu_request_millicores"` // cpu.weight 에서 역산한 CPU requests 합계의 근사값
+	MemoryLimitBytes     *int64   `json:"memory_limit_bytes"`     // memory.max
+	MemoryLimitPercent   *float64 `json:"memory_limit_percent"`   // working set / memory_limit_bytes
+	MemoryHighBytes      *int64   `json:"memory_high_bytes"`      // memory.high, 넘으면 회수가 강제되어 느려짐
+}
+
+// PodEphemeralStorageResponse 는 파드가 노드 디스크에 사용하는 임시 스토리지 용량입니다.
+type PodEphemeralStorageResponse struct {
+	EmptyDirBytes      int64 `json:"empty_dir_bytes"`      // 디스크 기반 emptyDir 볼륨
+	WritableLayerBytes int64 `json:"writable_layer_bytes"` // 컨테이너 쓰기 레이어 (overlay upperdir)
+	TotalBytes         int64 `json:"total_bytes"`
+}
+
+// PodProcessResponse 는 파드의 프로세스 수와 열린 파일 디스크립터 수입니다.
+// 수집하지 못한 항목은 null 입니다.
+type PodProcessResponse struct {
+	Pids      *int64   `json:"pids"`             // pids.current (스레드 포함)
+	PidsMax   *int64   `json:"pids_max"`         // pids.max, null 이면 제한 없음
+	PidsRatio *float64 `json:"pids_usage_ratio"` // pids / pids_max
+	OpenFds   *int64   `json:"open_fds"`         // 파드 프로세스들의 /proc/<pid>/fd 항목 수 합계
+}
+
+// PodInterfaceMetricsResponse 는 파드 네트워크 네임스페이스의 인터페이스별 메트릭입니다.
+type PodInterfaceMetricsResponse struct {
+	InterfaceName     string  `json:"interface_name"`
+	NetworkRxBytes    int64   `json:"network_rx_bytes"`
+	NetworkTxBytes    int64   `json:"network_tx_bytes"`
+	NetworkRxPackets  int64   `json:"network_rx_packets"`
+	NetworkTxPackets  int64   `json:"network_tx_packets"`
+	NetworkRxErrors   int64   `json:"network_rx_errors"`
+	NetworkTxErrors   int64   `json:"network_tx_errors"`
+	NetworkRxDropped  int64   `json:"network_rx_dropped"`
+	NetworkTxDropped  int64   `json:"network_tx_dropped"`
+	NetworkRxRate     float64 `json:"network_rx_rate"`      // bytes/sec
+	NetworkTxRate     float64 `json:"network_tx_rate"`      // bytes/sec
+	NetworkRxPktsRate float64 `json:"network_rx_pkts_rate"` // packets/sec
+	NetworkTxPktsRate float64 `json:"network_tx_pkts_rate"` // packets/sec
+}
+
+// PodTCPResponse 는 파드 네트워크 네임스페이스의 TCP 연결 상태별 개수와 오류 지표입니다.
+// CLOSE_WAIT 가 계속 늘어나면 애플리케이션이 상대가 닫은 연결을 닫지 않고 있는 것입니다.
+type PodTCPResponse struct {
+	Established        int64   `json:"established"`
+	SynSent            int64   `json:"syn_sent"`
+	SynRecv            int64   `json:"syn_recv"`
+	FinWait            int64   `json:"fin_wait"` // FIN_WAIT1 + FIN_WAIT2
+	TimeWait           int64   `json:"time_wait"`
+	CloseWait          int64   `json:"close_wait"`
+	LastAck            int64   `json:"last_ack"`
+	Listen             int64   `json:"listen"`
+	RetransSegs        int64   `json:"retrans_segs"`         // 누적 재전송 세그먼트 수
+	ListenOverflows    int64   `json:"listen_overflows"`     // 누적 accept 큐 초과 횟수
+	ListenDrops        int64   `json:"listen_drops"`         // 누적 연결 요청 드롭 수
+	RetransRate        float64 `json:"retrans_rate"`         // segments/sec
+	RetransRatio       float64 `json:"retrans_ratio"`        // 재전송 세그먼트 / 전송 세그먼트
+	ListenOverflowRate float64 `json:"listen_overflow_rate"` // overflows/sec
+	ListenDropRate     float64 `json:"listen_drop_rate"`     // drops/sec
+}
+
+// ThrottledPodResponse 는 CPU 스로틀링 조회 API의 응답 구조체입니다.
+// 지정된 시간 구간 동안 CFS 스로틀링이 발생한 비율을 제공합니다.
+type ThrottledPodResponse struct {
+	PodName          string    `json:"pod_name"`
+	DeploymentName   *string   `json:"deployment_name,omitempty"`
+	NamespaceName    string    `json:"namespace_name"`
+	NodeName         string    `json:"node_name"`
+	UID              string    `json:"uid"`
+	Window           string    `json:"window"`
+	StartTime        time.Time `json:"start_time"`
+	EndTime          time.Time `json:"end_time"`
+	CpuThrottleRatio float64   `json:"cpu_throttle_ratio"` // nr_throttled / nr_periods
+	CpuPeriods       int64     `json:"cpu_periods"`
+	CpuThrottled     int64     `json:"cpu_throttled"`
+	CpuThrottledSecs float64   `json:"cpu_throttled_seconds"`
 }
 
 // PodTimeSeriesResponse 는 Pod 시계열 조회 API의 응답 구조체입니다.
@@ -36,4 +155,5 @@ type PodTimeSeriesResponse struct {
 	AvgDiskWriteRate float64   `json:"avg_disk_write_rate"` // bytes/sec
 	AvgNetworkRxRate float64   `json:"avg_network_rx_rate"` // bytes/sec
 	AvgNetworkTxRate float64   `json:"avg_network_tx_rate"` // bytes/sec
+	HostNetwork      bool      `json:"host_network"`        // true 이면 avg_network_* 는 노드 전체의 값
 }
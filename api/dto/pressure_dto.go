@@ -0,0 +1,64 @@
+package dto
+
+import "time"
+
+// PressureResponse 는 자원별 PSI(Pressure Stall Information) 메트릭입니다.
+// avg 값과 stall_percent 는 자원을 기다리며 멈춘 시간의 비율(%)입니다.
+type PressureResponse struct {
+	Resource         string  `json:"resource"` // cpu, memory, io
+	SomeAvg10        float64 `json:"some_avg10"`
+	SomeAvg60        float64 `json:"some_avg60"`
+	SomeAvg300       float64 `json:"some_avg300"`
+	SomeTotalUsec    int64   `json:"some_total_usec"`
+	SomeStallPercent float64 `json:"some_stall_percent"` // 직전 수집 구간 기준
+	FullAvg10        float64 `json:"full_avg10"`
+	FullAvg60        float64 `json:"full_avg60"`
+	FullAvg300       float64 `json:"full_avg300"`
+	FullTotalUsec    int64   `json:"full_total_usec"`
+	FullStallPercent float64 `json:"full_stall_percent"` // 직전 수집 구간 기준
+}
+
+type NodePressureResponse struct {
+	NodeName  string              `json:"node_name"`
+	Timestamp time.Time           `json:"timestamp"`
+	Pressure  []*PressureResponse `json:"pressure"`
+}
+
+type PodPressureResponse struct {
+	PodName       string              `json:"pod_name"`
+	NamespaceName string              `json:"namespace_name"`
+	NodeName      string              `json:"node_name"`
+	UID           string              `json:"uid"`
+	Timestamp     time.Time           `json:"timestamp"`
+	Pressure      []*PressureResponse `json:"pressure"`
+}
+
+// PressureTimeSeriesResponse 는 지정된 시간 구간 동안의 자원별 PSI 요약입니다.
+type PressureTimeSeriesResponse struct {
+	Resource            string  `json:"resource"`
+	AvgSomeStallPercent float64 `json:"avg_some_stall_percent"`
+	AvgFullStallPercent float64 `json:"avg_full_stall_percent"`
+	MaxSomeAvg10        float64 `json:"max_some_avg10"`
+	MaxFullAvg10        float64 `json:"max_full_avg10"`
+}
+
+// NodePressureTimeSeriesResponse 는 Node PSI 시계열 조회 API의 응답 구조체입니다.
+type NodePressureTimeSeriesResponse struct {
+	NodeName  string                        `json:"node_name"`
+	Window    string                        `json:"window"`
+	StartTime time.Time                     `json:"start_time"`
+	EndTime   time.Time                     `json:"end_time"`
+	Pressure  []*PressureTimeSeriesResponse `json:"pressure"`
+}
+
+// PodPressureTimeSeriesResponse 는 Pod PSI 시계열 조회 API의 응답 구조체입니다.
+type PodPressureTimeSeriesResponse struct {
+	PodName       string                        `json:"pod_name"`
+	NamespaceName string                        `json:"namespace_name"`
+	NodeName      string                        `json:"node_name"`
+	UID           string                        `json:"uid"`
+	Window        string                        `json:"window"`
+	StartTime     time.Time                     `json:"start_time"`
+	EndTime       time.Time                     `json:"end_time"`
+	Pressure      []*PressureTimeSeriesResponse `json:"pressure"`
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type ContainerMetrics struct {
+	ID             uint64    `db:"id"`
+	Timestamp      time.Time `db:"timestamp"`
+	PodName        string    `db:"pod_name"`
+	PodUID         string    `db:"pod_uid"`
+	ContainerID    string    `db:"container_id"`
+	CPUUsageUsec   int64     `db:"cpu_usage_usec"`
+	MemoryUsage    int64     `db:"memory_usage"`
+	DiskReadBytes  int64     `db:"disk_read_bytes"`
+	DiskWriteBytes int64     `db:"disk_write_bytes"`
+	NamespaceName  string    `db:"namespace_name"`
+	NodeName       string    `db:"node_name"`
+
+	CPUNrPeriods     int64 `db:"cpu_nr_periods"`
+	CPUNrThrottled   int64 `db:"cpu_nr_throttled"`
+	CPUThrottledUsec int64 `db:"cpu_throttled_usec"`
+
+	ContainerName string `db:"container_name"`
+	Image         string `db:"image"`
+	RestartCount  int64  `db:"restart_count"`
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type NodeCpuMetrics struct {
+	ID               uint64    `db:"id"`
+	Timestamp        time.Time `db:"timestamp"`
+	NodeName         string    `db:"node_name"`
+	CPU              string    `db:"cpu"`
+	UserSeconds      float64   `db:"user_seconds"`
+	NiceSeconds      float64   `db:"nice_seconds"`
+	SystemSeconds    float64   `db:"system_seconds"`
+	IdleSeconds      float64   `db:"idle_seconds"`
+	IowaitSeconds    float64   `db:"iowait_seconds"`
+	IrqSeconds       float64   `db:"irq_seconds"`
+	SoftirqSeconds   float64   `db:"softirq_seconds"`
+	StealSeconds     float64   `db:"steal_seconds"`
+	GuestSeconds     float64   `db:"guest_seconds"`
+	GuestNiceSeconds float64   `db:"guest_nice_seconds"`
+}
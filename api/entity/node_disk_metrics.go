@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type NodeDiskMetrics struct {
+	ID               uint64    `db:"id"`
+	Timestamp        time.Time `db:"timestamp"`
+	NodeName         string    `db:"node_name"`
+	Device           string    `db:"device"`
+	ReadBytes        int64     `db:"read_bytes"`
+	WriteBytes       int64     `db:"write_bytes"`
+	ReadCount        int64     `db:"read_count"`
+	WriteCount       int64     `db:"write_count"`
+	MergedReadCount  int64     `db:"merged_read_count"`
+	MergedWriteCount int64     `db:"merged_write_count"`
+	ReadTime         int64     `db:"read_time"`        // ms
+	WriteTime        int64     `db:"write_time"`       // ms
+	IoTime           int64     `db:"io_time"`          // ms
+	WeightedIoTime   int64     `db:"weighted_io_time"` // ms
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type NodeFilesystemMetrics struct {
+	ID          uint64    `db:"id"`
+	Timestamp   time.Time `db:"timestamp"`
+	NodeName    string    `db:"node_name"`
+	Mountpoint  string    `db:"mountpoint"`
+	Device      string    `db:"device"`
+	FsType      string    `db:"fs_type"`
+	SizeBytes   int64     `db:"size_bytes"`
+	UsedBytes   int64     `db:"used_bytes"`
+	AvailBytes  int64     `db:"avail_bytes"`
+	InodesTotal int64     `db:"inodes_total"`
+	InodesUsed  int64     `db:"inodes_used"`
+}
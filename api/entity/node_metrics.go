@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"database/sql"
+	"time"
+)
 
 type NodeMetrics struct {
 	ID              uint64    `db:"id"`
@@ -16,4 +19,44 @@ type NodeMetrics struct {
 	DiskWriteBytes  int64     `db:"disk_write_bytes"`
 	NetworkRxBytes  int64     `db:"network_rx_bytes"`
 	NetworkTxBytes  int64     `db:"network_tx_bytes"`
+
+	// 필드 묶음별 수집 여부, false 이면 해당 묶음의 값은 0
+	CPUValid     bool `db:"cpu_valid"`
+	MemoryValid  bool `db:"memory_valid"`
+	DiskValid    bool `db:"disk_valid"`
+	NetworkValid bool `db:"network_valid"`
+
+	CPUUserSeconds      float64 `db:"cpu_user_seconds"`
+	CPUNiceSeconds      float64 `db:"cpu_nice_seconds"`
+	CPUSystemSeconds    float64 `db:"cpu_system_seconds"`
+	CPUIdleSeconds      float64 `db:"cpu_idle_seconds"`
+	CPUIowaitSeconds    float64 `db:"cpu_iowait_seconds"`
+	CPUIrqSeconds       float64 `db:"cpu_irq_seconds"`
+	CPUSoftirqSeconds   float64 `db:"cpu_softirq_seconds"`
+	CPUStealSeconds     float64 `db:"cpu_steal_seconds"`
+	CPUGuestSeconds     float64 `db:"cpu_guest_seconds"`
+	CPUGuestNiceSeconds float64 `db:"cpu_guest_nice_seconds"`
+
+	MemoryBuffers           int64 `db:"memory_buffers"`
+	MemoryCached            int64 `db:"memory_cached"`
+	MemoryDirty             int64 `db:"memory_dirty"`
+	MemorySlabReclaimable   int64 `db:"memory_slab_reclaimable"`
+	MemorySlabUnreclaimable int64 `db:"memory_slab_unreclaimable"`
+	SwapTotal               int64 `db:"swap_total"`
+	SwapUsed                int64 `db:"swap_used"`
+	HugePagesTotal          int64 `db:"hugepages_total"`
+	HugePagesFree           int64 `db:"hugepages_free"`
+	HugePageSize            int64 `db:"hugepage_size"`
+
+	// 시스템 정보는 수집 실패 시, 또는 수집 이전에 저장된 메트릭이면 NULL
+	BootID          sql.NullString  `db:"boot_id"`
+	BootTime        sql.NullTime    `db:"boot_time"`
+	UptimeSeconds   sql.NullInt64   `db:"uptime_seconds"`
+	Load1           sql.NullFloat64 `db:"load1"`
+	Load5           sql.NullFloat64 `db:"load5"`
+	Load15          sql.NullFloat64 `db:"load15"`
+	ContextSwitches sql.NullInt64   `db:"context_switches"`
+	ProcsTotal      sql.NullInt64   `db:"procs_total"`
+	ProcsRunning    sql.NullInt64   `db:"procs_running"`
+	ProcsBlocked    sql.NullInt64   `db:"procs_blocked"`
 }
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type PodDiskMetrics struct {
+	ID            uint64    `db:"id"`
+	Timestamp     time.Time `db:"timestamp"`
+	PodName       string    `db:"pod_name"`
+	PodUID        string    `db:"pod_uid"`
+	NamespaceName string    `db:"namespace_name"`
+	NodeName      string    `db:"node_name"`
+	Device        string    `db:"device"`
+	Major         int64     `db:"major"`
+	Minor         int64     `db:"minor"`
+	ReadBytes     int64     `db:"read_bytes"`
+	WriteBytes    int64     `db:"write_bytes"`
+	ReadCount     int64     `db:"read_count"`
+	WriteCount    int64     `db:"write_count"`
+}
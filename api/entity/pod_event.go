@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type PodEvent struct {
+	ID            uint64    `db:"id"`
+	Timestamp     time.Time `db:"timestamp"`
+	PodName       string    `db:"pod_name"`
+	PodUID        string    `db:"pod_uid"`
+	NamespaceName string    `db:"namespace_name"`
+	NodeName      string    `db:"node_name"`
+	EventType     string    `db:"event_type"`
+	Count         int64     `db:"count"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+type PodInterfaceMetrics struct {
+	ID            uint64    `db:"id"`
+	Timestamp     time.Time `db:"timestamp"`
+	PodName       string    `db:"pod_name"`
+	PodUID        string    `db:"pod_uid"`
+	NamespaceName string    `db:"namespace_name"`
+	NodeName      string    `db:"node_name"`
+	InterfaceName string    `db:"interface_name"`
+	RxBytes       int64     `db:"rx_bytes"`
+	TxBytes       int64     `db:"tx_bytes"`
+	RxPackets     int64     `db:"rx_packets"`
+	TxPackets     int64     `db:"tx_packets"`
+	RxErrors      int64     `db:"rx_errors"`
+	TxErrors      int64     `db:"tx_errors"`
+	RxDropped     int64     `db:"rx_dropped"`
+	TxDropped     int64     `db:"tx_dropped"`
+}
@@ -19,4 +19,62 @@ type PodMetrics struct {
 	NamespaceName  string         `db:"namespace_name"`
 	DeploymentName sql.NullString `db:"deployment_name"`
 	NodeName       string         `db:"node_name"`
+
+	// 필드 묶음별 수집 여부, false 이면 해당 묶음의 값은 0
+	CPUValid          bool `db:"cpu_valid"`
+	MemoryValid       bool `db:"memory_valid"`
+	MemoryEventsValid bool `db:"memory_events_valid"`
+	DiskValid         bool `db:"disk_valid"`
+	NetworkValid      bool `db:"network_valid"`
+
+	MemoryWorkingSet int64 `db:"memory_working_set"`
+	MemoryRSS        int64 `db:"memory_rss"`
+	MemoryCache      int64 `db:"memory_cache"`
+	MemoryKernel     int64 `db:"memory_kernel"`
+	MemorySock       int64 `db:"memory_sock"`
+	MemorySwap       int64 `db:"memory_swap"`
+
+	CPUNrPeriods     int64 `db:"cpu_nr_periods"`
+	CPUNrThrottled   int64 `db:"cpu_nr_throttled"`
+	CPUThrottledUsec int64 `db:"cpu_throttled_usec"`
+
+	MemoryHighEvents int64 `db:"memory_high_events"`
+	MemoryMaxEvents  int64 `db:"memory_max_events"`
+	OomEvents        int64 `db:"oom_events"`
+	OomKillEvents    int64 `db:"oom_kill_events"`
+
+	EphemeralEmptyDirBytes      sql.NullInt64 `db:"ephemeral_empty_dir_bytes"`      // 수집 실패 시 NULL
+	EphemeralWritableLayerBytes sql.NullInt64 `db:"ephemeral_writable_layer_bytes"` // 수집 실패 시 NULL
+
+	PidsCurrent sql.NullInt64 `db:"pids_current"` // 수집 실패 시 NULL
+	PidsMax     sql.NullInt64 `db:"pids_max"`     // 0 은 제한 없음, 수집 실패 시 NULL
+	OpenFds     sql.NullInt64 `db:"open_fds"`     // 수집 실패 시 NULL
+
+	DiskReadCount  int64 `db:"disk_read_count"`
+	DiskWriteCount int64 `db:"disk_write_count"`
+
+	// TCP 연결 통계, 수집 실패 시 NULL
+	TCPEstablished     sql.NullInt64 `db:"tcp_established"`
+	TCPSynSent         sql.NullInt64 `db:"tcp_syn_sent"`
+	TCPSynRecv         sql.NullInt64 `db:"tcp_syn_recv"`
+	TCPFinWait         sql.NullInt64 `db:"tcp_fin_wait"`
+	TCPTimeWait        sql.NullInt64 `db:"tcp_time_wait"`
+	TCPCloseWait       sql.NullInt64 `db:"tcp_close_wait"`
+	TCPLastAck         sql.NullInt64 `db:"tcp_last_ack"`
+	TCPListen          sql.NullInt64 `db:"tcp_listen"`
+	TCPOutSegs         sql.NullInt64 `db:"tcp_out_segs"`
+	TCPRetransSegs     sql.NullInt64 `db:"tcp_retrans_segs"`
+	TCPListenOverflows sql.NullInt64 `db:"tcp_listen_overflows"`
+	TCPListenDrops     sql.NullInt64 `db:"tcp_listen_drops"`
+
+	HostNetwork bool `db:"host_network"` // true 이면 network_*, tcp_* 는 노드 전체의 값
+
+	// 파드 cgroup 의 QoS 등급과 CPU, 메모리 제한
+	// 제한 값의 0 은 제한 없음, 수집 실패 시 NULL
+	QoSClass      sql.NullString `db:"qos_class"`
+	CPUQuotaUsec  sql.NullInt64  `db:"cpu_quota_usec"`
+	CPUPeriodUsec sql.NullInt64  `db:"cpu_period_usec"`
+	CPUWeight     sql.NullInt64  `db:"cpu_weight"`
+	MemoryMax     sql.NullInt64  `db:"memory_max"`
+	MemoryHigh    sql.NullInt64  `db:"memory_high"`
 }
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// PressureMetrics 는 node_pressure_metrics 와 pod_pressure_metrics 에 공통으로 존재하는 PSI 컬럼입니다.
+type PressureMetrics struct {
+	Timestamp  time.Time `db:"timestamp"`
+	Resource   string    `db:"resource"`
+	SomeAvg10  float64   `db:"some_avg10"`
+	SomeAvg60  float64   `db:"some_avg60"`
+	SomeAvg300 float64   `db:"some_avg300"`
+	SomeTotal  int64     `db:"some_total"`
+	FullAvg10  float64   `db:"full_avg10"`
+	FullAvg60  float64   `db:"full_avg60"`
+	FullAvg300 float64   `db:"full_avg300"`
+	FullTotal  int64     `db:"full_total"`
+
+	// 같은 시각에 수집된 노드 메트릭의 boot ID 와 가동 시간, 재부팅으로 total 이 초기화되었는지 판단하는 데 사용
+	BootID        sql.NullString `db:"boot_id"`
+	UptimeSeconds sql.NullInt64  `db:"uptime_seconds"`
+}
+
+type NodePressureMetrics struct {
+	ID       uint64 `db:"id"`
+	NodeName string `db:"node_name"`
+	PressureMetrics
+}
+
+type PodPressureMetrics struct {
+	ID            uint64 `db:"id"`
+	PodName       string `db:"pod_name"`
+	PodUID        string `db:"pod_uid"`
+	NamespaceName string `db:"namespace_name"`
+	NodeName      string `db:"node_name"`
+	PressureMetrics
+}
@@ -43,28 +43,42 @@ func main() {
 	podRepository := repository.NewPodRepository(db)
 	namespaceRepository := repository.NewNamespaceRepository(db)
 	deploymentRepository := repository.NewDeploymentRepository(db)
+	containerRepository := repository.NewContainerRepository(db)
+	pressureRepository := repository.NewPressureRepository(db)
+	eventRepository := repository.NewEventRepository(db)
 
 	nodeService := service.NewNodeService(nodeRepository)
 	podService := service.NewPodService(podRepository)
 	namespaceService := service.NewNamespaceService(namespaceRepository)
 	deploymentService := service.NewDeploymentService(deploymentRepository)
+	containerService := service.NewContainerService(containerRepository)
+	pressureService := service.NewPressureService(pressureRepository)
+	eventService := service.NewEventService(eventRepository)
 
 	nodeController := controller.NewNodeController(nodeService, podService)
-	podController := controller.NewPodController(podService)
+	podController := controller.NewPodController(podService, containerService)
 	namespaceController := controller.NewNamespaceController(namespaceService)
 	deploymentController := controller.NewDeploymentController(deploymentService)
+	pressureController := controller.NewPressureController(pressureService)
+	eventController := controller.NewEventController(eventService)
 
 	// 라우트 설정
 	app.Get("/api/nodes", nodeController.GetMetricsList)
 	app.Get("/api/nodes/:nodeName", nodeController.GetMetricsByNodeName)
 	app.Get("/api/nodes/:nodeName/pods", nodeController.GetPodMetricsListByNodeName)
+	app.Get("/api/nodes/:nodeName/pressure", pressureController.GetPressureByNodeName)
 
 	app.Get("/api/pods", podController.GetMetricsList)
 	app.Get("/api/pods/:podName", podController.GetMetricsByPodName)
+	app.Get("/api/pods/:podName/containers", podController.GetContainerMetricsByPodName)
+	app.Get("/api/pods/:podName/pressure", pressureController.GetPressureByPodName)
+
+	app.Get("/api/throttled", podController.GetThrottledPods)
 
 	app.Get("/api/namespaces", namespaceController.GetMetricsList)
 	app.Get("/api/namespaces/:namespaceName", namespaceController.GetMetricsByNamespaceName)
 	app.Get("/api/namespaces/:namespaceName/pods", namespaceController.GetPodMetricsListByNamespaceName)
+	app.Get("/api/namespaces/:namespaceName/oom-events", eventController.GetOomEventsByNamespaceName)
 
 	app.Get("/api/namespaces/:namespaceName/deployments", deploymentController.GetDeploymentsByNamespaceName)
 	app.Get("/api/namespaces/:namespaceName/deployments/:deploymentName", deploymentController.GetMetricsByDeploymentName)
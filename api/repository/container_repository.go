@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+type ContainerRepository interface {
+	FindByPodName(podName string) ([]*entity.ContainerMetrics, error)
+}
+
+type containerRepository struct {
+	db *sqlx.DB
+}
+
+func NewContainerRepository(db *sqlx.DB) ContainerRepository {
+	return &containerRepository{
+		db: db,
+	}
+}
+
+// FindByPodName 은 주어진 파드명의 컨테이너들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
+func (r *containerRepository) FindByPodName(podName string) ([]*entity.ContainerMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY container_id ORDER BY timestamp DESC) AS rn
+			FROM container_metrics
+			WHERE pod_name = $1
+		)
+		SELECT
+			id, timestamp, pod_name, pod_uid, container_id, cpu_usage_usec, memory_usage,
+			disk_read_bytes, disk_write_bytes, namespace_name, node_name,
+			cpu_nr_periods, cpu_nr_throttled, cpu_throttled_usec,
+			container_name, image, restart_count
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY container_id, timestamp DESC;
+	`
+
+	var metrics []*entity.ContainerMetrics
+	err := r.db.Select(&metrics, query, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
@@ -30,10 +30,7 @@ func (r *deploymentRepository) FindByNamespaceName(namespaceName string) ([]*ent
 			FROM pod_metrics
 			WHERE namespace_name = $1 AND deployment_name IS NOT NULL
 		)
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY deployment_name, pod_name, timestamp DESC;
@@ -58,10 +55,7 @@ func (r *deploymentRepository) FindByDeploymentName(namespaceName, deploymentNam
 			FROM pod_metrics
 			WHERE namespace_name = $1 AND deployment_name = $2
 		)
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY pod_name, timestamp DESC;
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+type EventRepository interface {
+	FindOomEventsByNamespaceName(namespaceName string, startTime, endTime time.Time) ([]*entity.PodEvent, error)
+}
+
+type eventRepository struct {
+	db *sqlx.DB
+}
+
+func NewEventRepository(db *sqlx.DB) EventRepository {
+	return &eventRepository{
+		db: db,
+	}
+}
+
+// FindOomEventsByNamespaceName 은 주어진 네임스페이스와 시간 범위에서 발생한 OOM 이벤트를 최신순으로 조회합니다.
+func (r *eventRepository) FindOomEventsByNamespaceName(namespaceName string, startTime, endTime time.Time) ([]*entity.PodEvent, error) {
+	query := `
+		SELECT
+			id, timestamp, pod_name, pod_uid, namespace_name, node_name, event_type, count
+		FROM pod_events
+		WHERE namespace_name = $1
+		  AND event_type IN ('oom', 'oom_kill')
+		  AND timestamp >= $2
+		  AND timestamp <= $3
+		ORDER BY timestamp DESC, pod_name;
+	`
+
+	var events []*entity.PodEvent
+	err := r.db.Select(&events, query, namespaceName, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
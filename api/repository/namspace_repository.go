@@ -33,10 +33,7 @@ func (r *namespaceRepository) FindAll() ([]*entity.PodMetrics, error) {
 			FROM pod_metrics
 			WHERE namespace_name IS NOT NULL
 		)
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY pod_name, timestamp DESC;
@@ -54,10 +51,7 @@ func (r *namespaceRepository) FindAll() ([]*entity.PodMetrics, error) {
 // FindByNamespaceNameInTimeWindow 는 주어진 네임스페이스명과 시간 범위에 대한 파드 메트릭을 조회합니다.
 func (r *namespaceRepository) FindByNamespaceNameInTimeWindow(namespaceName string, startTime, endTime time.Time) ([]*entity.PodMetrics, error) {
 	query := `
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM pod_metrics
 		WHERE namespace_name = $1
 		  AND timestamp >= $2
@@ -84,10 +78,7 @@ func (r *namespaceRepository) FindByNamespaceName(namespaceName string) ([]*enti
 			FROM pod_metrics
 			WHERE namespace_name = $1
 		)
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY pod_name, timestamp DESC;
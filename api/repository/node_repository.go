@@ -7,10 +7,53 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// nodeMetricsColumns 는 entity.NodeMetrics 에 매핑되는 node_metrics 컬럼 목록입니다.
+// 수집하지 못한 필드 묶음의 컬럼은 NULL 로 저장되므로 0 으로 조회하고, 묶음별 수집 여부를 *_valid 로 함께 조회합니다.
+const nodeMetricsColumns = `
+			id, timestamp, node_name,
+			COALESCE(cpu_total, 0) AS cpu_total, COALESCE(cpu_busy, 0) AS cpu_busy, COALESCE(cpu_count, 0) AS cpu_count,
+			COALESCE(memory_total, 0) AS memory_total, COALESCE(memory_available, 0) AS memory_available, COALESCE(memory_used, 0) AS memory_used,
+			COALESCE(disk_read_bytes, 0) AS disk_read_bytes, COALESCE(disk_write_bytes, 0) AS disk_write_bytes,
+			COALESCE(network_rx_bytes, 0) AS network_rx_bytes, COALESCE(network_tx_bytes, 0) AS network_tx_bytes,
+			COALESCE(cpu_user_seconds, 0) AS cpu_user_seconds, COALESCE(cpu_nice_seconds, 0) AS cpu_nice_seconds,
+			COALESCE(cpu_system_seconds, 0) AS cpu_system_seconds, COALESCE(cpu_idle_seconds, 0) AS cpu_idle_seconds,
+			COALESCE(cpu_iowait_seconds, 0) AS cpu_iowait_seconds, COALESCE(cpu_irq_seconds, 0) AS cpu_irq_seconds,
+			COALESCE(cpu_softirq_seconds, 0) AS cpu_softirq_seconds, COALESCE(cpu_steal_seconds, 0) AS cpu_steal_seconds,
+			COALESCE(cpu_guest_seconds, 0) AS cpu_guest_seconds, COALESCE(cpu_guest_nice_seconds, 0) AS cpu_guest_nice_seconds,
+			COALESCE(memory_buffers, 0) AS memory_buffers, COALESCE(memory_cached, 0) AS memory_cached, COALESCE(memory_dirty, 0) AS memory_dirty,
+			COALESCE(memory_slab_reclaimable, 0) AS memory_slab_reclaimable, COALESCE(memory_slab_unreclaimable, 0) AS memory_slab_unreclaimable,
+			COALESCE(swap_total, 0) AS swap_total, COALESCE(swap_used, 0) AS swap_used,
+			COALESCE(hugepages_total, 0) AS hugepages_total, COALESCE(hugepages_free, 0) AS hugepages_free, COALESCE(hugepage_size, 0) AS hugepage_size,
+			cpu_total IS NOT NULL AS cpu_valid, memory_total IS NOT NULL AS memory_valid,
+			disk_read_bytes IS NOT NULL AS disk_valid, network_rx_bytes IS NOT NULL AS network_valid,
+			boot_id, boot_time, uptime_seconds, load1, load5, load15,
+			context_switches, procs_total, procs_running, procs_blocked`
+
+// nodeDiskMetricsColumns 는 entity.NodeDiskMetrics 에 매핑되는 node_disk_metrics 컬럼 목록입니다.
+const nodeDiskMetricsColumns = `
+			id, timestamp, node_name, device, read_bytes, write_bytes,
+			read_count, write_count, merged_read_count, merged_write_count,
+			read_time, write_time, io_time, weighted_io_time`
+
+// nodeCpuMetricsColumns 는 entity.NodeCpuMetrics 에 매핑되는 node_cpu_metrics 컬럼 목록입니다.
+const nodeCpuMetricsColumns = `
+			id, timestamp, node_name, cpu,
+			user_seconds, nice_seconds, system_seconds, idle_seconds, iowait_seconds,
+			irq_seconds, softirq_seconds, steal_seconds, guest_seconds, guest_nice_seconds`
+
 type NodeRepository interface {
 	FindAll() ([]*entity.NodeMetrics, error)
 	FindByNodeName(nodeName string) ([]*entity.NodeMetrics, error)
 	FindByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodeMetrics, error)
+	FindAllDisks() ([]*entity.NodeDiskMetrics, error)
+	FindDisksByNodeName(nodeName string) ([]*entity.NodeDiskMetrics, error)
+	FindDisksByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodeDiskMetrics, error)
+	FindAllInterfaces() ([]*entity.NodeInterfaceMetrics, error)
+	FindInterfacesByNodeName(nodeName string) ([]*entity.NodeInterfaceMetrics, error)
+	FindAllCpus() ([]*entity.NodeCpuMetrics, error)
+	FindCpusByNodeName(nodeName string) ([]*entity.NodeCpuMetrics, error)
+	FindAllFilesystems(startTime, endTime time.Time) ([]*entity.NodeFilesystemMetrics, error)
+	FindFilesystemsByNodeName(nodeName string, startTime, endTime time.Time) ([]*entity.NodeFilesystemMetrics, error)
 }
 
 type nodeRepository struct {
@@ -32,10 +75,7 @@ func (r *nodeRepository) FindAll() ([]*entity.NodeMetrics, error) {
 		        ROW_NUMBER() OVER (PARTITION BY node_name ORDER BY timestamp DESC) AS rn
 		    FROM node_metrics
 		)
-		SELECT
-			id, timestamp, node_name, cpu_total, cpu_busy, cpu_count,
-			memory_total, memory_available, memory_used,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes
+		SELECT ` + nodeMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY node_name, timestamp DESC;
@@ -53,7 +93,7 @@ func (r *nodeRepository) FindAll() ([]*entity.NodeMetrics, error) {
 // FindByNodeName 은 주어진 노드명에 대하여 가장 최근의 2개의 메트릭을 조회합니다.
 func (r *nodeRepository) FindByNodeName(nodeName string) ([]*entity.NodeMetrics, error) {
 	query := `
-		SELECT *
+		SELECT ` + nodeMetricsColumns + `
 		FROM node_metrics
 		WHERE node_name = $1
 		ORDER BY timestamp DESC
@@ -72,10 +112,7 @@ func (r *nodeRepository) FindByNodeName(nodeName string) ([]*entity.NodeMetrics,
 // FindByNodeNameInTimeWindow 는 주어진 노드명과 시간 범위에 대한 메트릭을 조회합니다.
 func (r *nodeRepository) FindByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodeMetrics, error) {
 	query := `
-		SELECT
-			id, timestamp, node_name, cpu_total, cpu_busy, cpu_count,
-			memory_total, memory_available, memory_used,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes
+		SELECT ` + nodeMetricsColumns + `
 		FROM node_metrics
 		WHERE node_name = $1
 		  AND timestamp >= $2
@@ -91,3 +128,234 @@ func (r *nodeRepository) FindByNodeNameInTimeWindow(nodeName string, startTime,
 
 	return metrics, nil
 }
+
+// FindAllDisks 는 모든 노드의 장치별로 가장 최근의 2개의 디스크 메트릭을 조회합니다.
+func (r *nodeRepository) FindAllDisks() ([]*entity.NodeDiskMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY node_name, device ORDER BY timestamp DESC) AS rn
+			FROM node_disk_metrics
+		)
+		SELECT ` + nodeDiskMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY node_name, device, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeDiskMetrics
+	err := r.db.Select(&metrics, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindDisksByNodeName 은 주어진 노드명의 장치별로 가장 최근의 2개의 디스크 메트릭을 조회합니다.
+func (r *nodeRepository) FindDisksByNodeName(nodeName string) ([]*entity.NodeDiskMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY device ORDER BY timestamp DESC) AS rn
+			FROM node_disk_metrics
+			WHERE node_name = $1
+		)
+		SELECT ` + nodeDiskMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY device, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeDiskMetrics
+	err := r.db.Select(&metrics, query, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindDisksByNodeNameInTimeWindow 는 주어진 노드명과 시간 범위에 대한 장치별 디스크 메트릭을 조회합니다.
+func (r *nodeRepository) FindDisksByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodeDiskMetrics, error) {
+	query := `
+		SELECT ` + nodeDiskMetricsColumns + `
+		FROM node_disk_metrics
+		WHERE node_name = $1
+		  AND timestamp >= $2
+		  AND timestamp <= $3
+		ORDER BY device, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeDiskMetrics
+	err := r.db.Select(&metrics, query, nodeName, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindAllInterfaces 는 모든 노드의 인터페이스별로 가장 최근의 2개의 네트워크 메트릭을 조회합니다.
+func (r *nodeRepository) FindAllInterfaces() ([]*entity.NodeInterfaceMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY node_name, interface_name ORDER BY timestamp DESC) AS rn
+			FROM node_interface_metrics
+		)
+		SELECT
+			id, timestamp, node_name, interface_name, rx_bytes, tx_bytes,
+			rx_packets, tx_packets, rx_errors, tx_errors, rx_dropped, tx_dropped
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY node_name, interface_name, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeInterfaceMetrics
+	err := r.db.Select(&metrics, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindInterfacesByNodeName 은 주어진 노드명의 인터페이스별로 가장 최근의 2개의 네트워크 메트릭을 조회합니다.
+func (r *nodeRepository) FindInterfacesByNodeName(nodeName string) ([]*entity.NodeInterfaceMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY interface_name ORDER BY timestamp DESC) AS rn
+			FROM node_interface_metrics
+			WHERE node_name = $1
+		)
+		SELECT
+			id, timestamp, node_name, interface_name, rx_bytes, tx_bytes,
+			rx_packets, tx_packets, rx_errors, tx_errors, rx_dropped, tx_dropped
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY interface_name, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeInterfaceMetrics
+	err := r.db.Select(&metrics, query, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindAllCpus 는 모든 노드의 논리 CPU 별로 가장 최근의 2개의 CPU 메트릭을 조회합니다.
+// 논리 CPU 는 번호 순서(cpu2 가 cpu10 보다 먼저)로 정렬됩니다.
+func (r *nodeRepository) FindAllCpus() ([]*entity.NodeCpuMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY node_name, cpu ORDER BY timestamp DESC) AS rn
+			FROM node_cpu_metrics
+		)
+		SELECT ` + nodeCpuMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY node_name, LENGTH(cpu), cpu, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeCpuMetrics
+	err := r.db.Select(&metrics, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindCpusByNodeName 은 주어진 노드명의 논리 CPU 별로 가장 최근의 2개의 CPU 메트릭을 조회합니다.
+func (r *nodeRepository) FindCpusByNodeName(nodeName string) ([]*entity.NodeCpuMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY cpu ORDER BY timestamp DESC) AS rn
+			FROM node_cpu_metrics
+			WHERE node_name = $1
+		)
+		SELECT ` + nodeCpuMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY LENGTH(cpu), cpu, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeCpuMetrics
+	err := r.db.Select(&metrics, query, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindAllFilesystems 는 모든 노드의 마운트 경로별로 시간 범위 내 가장 최근과 가장 오래된 파일시스템 메트릭을 조회합니다.
+func (r *nodeRepository) FindAllFilesystems(startTime, endTime time.Time) ([]*entity.NodeFilesystemMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY node_name, mountpoint ORDER BY timestamp DESC) AS rn_desc,
+				ROW_NUMBER() OVER (PARTITION BY node_name, mountpoint ORDER BY timestamp ASC) AS rn_asc
+			FROM node_filesystem_metrics
+			WHERE timestamp >= $1
+			  AND timestamp <= $2
+		)
+		SELECT
+			id, timestamp, node_name, mountpoint, device, fs_type,
+			size_bytes, used_bytes, avail_bytes, inodes_total, inodes_used
+		FROM ranked
+		WHERE rn_desc = 1 OR rn_asc = 1
+		ORDER BY node_name, mountpoint, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeFilesystemMetrics
+	err := r.db.Select(&metrics, query, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindFilesystemsByNodeName 은 주어진 노드명의 마운트 경로별로 시간 범위 내 가장 최근과 가장 오래된 파일시스템 메트릭을 조회합니다.
+func (r *nodeRepository) FindFilesystemsByNodeName(nodeName string, startTime, endTime time.Time) ([]*entity.NodeFilesystemMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY mountpoint ORDER BY timestamp DESC) AS rn_desc,
+				ROW_NUMBER() OVER (PARTITION BY mountpoint ORDER BY timestamp ASC) AS rn_asc
+			FROM node_filesystem_metrics
+			WHERE node_name = $1
+			  AND timestamp >= $2
+			  AND timestamp <= $3
+		)
+		SELECT
+			id, timestamp, node_name, mountpoint, device, fs_type,
+			size_bytes, used_bytes, avail_bytes, inodes_total, inodes_used
+		FROM ranked
+		WHERE rn_desc = 1 OR rn_asc = 1
+		ORDER BY mountpoint, timestamp DESC;
+	`
+
+	var metrics []*entity.NodeFilesystemMetrics
+	err := r.db.Select(&metrics, query, nodeName, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
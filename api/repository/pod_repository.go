@@ -8,11 +8,49 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// podMetricsColumns 는 entity.PodMetrics 에 매핑되는 pod_metrics 컬럼 목록입니다.
+// 수집하지 못한 필드 묶음의 컬럼은 NULL 로 저장되므로 0 으로 조회하고, 묶음별 수집 여부를 *_valid 로 함께 조회합니다.
+const podMetricsColumns = `
+			id, timestamp, pod_name, uid,
+			COALESCE(cpu_usage_usec, 0) AS cpu_usage_usec, COALESCE(memory_usage, 0) AS memory_usage,
+			COALESCE(disk_read_bytes, 0) AS disk_read_bytes, COALESCE(disk_write_bytes, 0) AS disk_write_bytes,
+			COALESCE(network_rx_bytes, 0) AS network_rx_bytes, COALESCE(network_tx_bytes, 0) AS network_tx_bytes,
+			namespace_name, deployment_name, node_name,
+			COALESCE(memory_working_set, 0) AS memory_working_set, COALESCE(memory_rss, 0) AS memory_rss, COALESCE(memory_cache, 0) AS memory_cache,
+			COALESCE(memory_kernel, 0) AS memory_kernel, COALESCE(memory_sock, 0) AS memory_sock, COALESCE(memory_swap, 0) AS memory_swap,
+			COALESCE(cpu_nr_periods, 0) AS cpu_nr_periods, COALESCE(cpu_nr_throttled, 0) AS cpu_nr_throttled, COALESCE(cpu_throttled_usec, 0) AS cpu_throttled_usec,
+			COALESCE(memory_high_events, 0) AS memory_high_events, COALESCE(memory_max_events, 0) AS memory_max_events,
+			COALESCE(oom_events, 0) AS oom_events, COALESCE(oom_kill_events, 0) AS oom_kill_events,
+			cpu_usage_usec IS NOT NULL AS cpu_valid, memory_usage IS NOT NULL AS memory_valid,
+			memory_high_events IS NOT NULL AS memory_events_valid,
+			disk_read_bytes IS NOT NULL AS disk_valid, network_rx_bytes IS NOT NULL AS network_valid,
+			ephemeral_empty_dir_bytes, ephemeral_writable_layer_bytes,
+			pids_current, pids_max, open_fds,
+			COALESCE(disk_read_count, 0) AS disk_read_count, COALESCE(disk_write_count, 0) AS disk_write_count,
+			tcp_established, tcp_syn_sent, tcp_syn_recv, tcp_fin_wait, tcp_time_wait,
+			tcp_close_wait, tcp_last_ack, tcp_listen,
+			tcp_out_segs, tcp_retrans_segs, tcp_listen_overflows, tcp_listen_drops,
+			host_network, qos_class,
+			cpu_quota_usec, cpu_period_usec, cpu_weight, memory_max, memory_high`
+
+// podDiskMetricsColumns 는 entity.PodDiskMetrics 에 매핑되는 pod_disk_metrics 컬럼 목록입니다.
+const podDiskMetricsColumns = `
+			id, timestamp, pod_name, pod_uid, namespace_name, node_name, device, major, minor,
+			read_bytes, write_bytes, read_count, write_count`
+
+// podInterfaceMetricsColumns 는 entity.PodInterfaceMetrics 에 매핑되는 pod_interface_metrics 컬럼 목록입니다.
+const podInterfaceMetricsColumns = `
+			id, timestamp, pod_name, pod_uid, namespace_name, node_name, interface_name,
+			rx_bytes, tx_bytes, rx_packets, tx_packets, rx_errors, tx_errors, rx_dropped, tx_dropped`
+
 type PodRepository interface {
 	FindAll() ([]*entity.PodMetrics, error)
 	FindByPodName(podName string) ([]*entity.PodMetrics, error)
 	FindByNodeName(nodeName string) ([]*entity.PodMetrics, error)
 	FindByPodNameInTimeWindow(podName string, startTime, endTime time.Time) ([]*entity.PodMetrics, error)
+	FindBoundsInTimeWindow(startTime, endTime time.Time) ([]*entity.PodMetrics, error)
+	FindDisksByPodUID(podUID string) ([]*entity.PodDiskMetrics, error)
+	FindInterfacesByPodUID(podUID string) ([]*entity.PodInterfaceMetrics, error)
 }
 
 type podRepository struct {
@@ -34,10 +72,7 @@ func (r *podRepository) FindAll() ([]*entity.PodMetrics, error) {
 				ROW_NUMBER() OVER (PARTITION BY pod_name ORDER BY timestamp DESC) AS rn
 			FROM pod_metrics
 		)
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM ranked
 		WHERE rn <= 2
 		ORDER BY pod_name, timestamp DESC;
@@ -55,7 +90,7 @@ func (r *podRepository) FindAll() ([]*entity.PodMetrics, error) {
 // FindByPodName 은 주어진 파드명에 대하여 가장 최근의 2개의 메트릭을 조회합니다.
 func (r *podRepository) FindByPodName(podName string) ([]*entity.PodMetrics, error) {
 	query := `
-		SELECT *
+		SELECT ` + podMetricsColumns + `
 		FROM pod_metrics
 		WHERE pod_name = $1
 		ORDER BY timestamp DESC
@@ -81,10 +116,7 @@ func (r *podRepository) FindByNodeName(nodeName string) ([]*entity.PodMetrics, e
             FROM pod_metrics
             WHERE node_name = $1
         )
-        SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+        SELECT ` + podMetricsColumns + `
         FROM ranked
         WHERE rn <= 2
         ORDER BY pod_name, timestamp DESC;
@@ -104,10 +136,7 @@ func (r *podRepository) FindByPodNameInTimeWindow(podName string, startTime, end
 	fmt.Println("Finding metrics for pod:", podName, "from", startTime, "to", endTime)
 
 	query := `
-		SELECT
-			id, timestamp, pod_name, uid, cpu_usage_usec, memory_usage,
-			disk_read_bytes, disk_write_bytes, network_rx_bytes, network_tx_bytes,
-			namespace_name, deployment_name, node_name
+		SELECT ` + podMetricsColumns + `
 		FROM pod_metrics
 		WHERE pod_name = $1
 		  AND timestamp >= $2
@@ -123,3 +152,82 @@ func (r *podRepository) FindByPodNameInTimeWindow(podName string, startTime, end
 
 	return metrics, nil
 }
+
+// FindBoundsInTimeWindow 는 시간 범위 내에서 각 파드의 가장 최근 메트릭과 가장 오래된 메트릭을 조회합니다.
+// 동일한 이름으로 재생성된 파드의 카운터가 섞이지 않도록 파드 UID 기준으로 구분합니다.
+func (r *podRepository) FindBoundsInTimeWindow(startTime, endTime time.Time) ([]*entity.PodMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY uid ORDER BY timestamp DESC) AS rn_desc,
+				ROW_NUMBER() OVER (PARTITION BY uid ORDER BY timestamp ASC) AS rn_asc
+			FROM pod_metrics
+			WHERE timestamp >= $1
+			  AND timestamp <= $2
+			  AND cpu_nr_periods IS NOT NULL
+		)
+		SELECT ` + podMetricsColumns + `
+		FROM ranked
+		WHERE rn_desc = 1 OR rn_asc = 1
+		ORDER BY uid, timestamp DESC;
+	`
+
+	var metrics []*entity.PodMetrics
+	err := r.db.Select(&metrics, query, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindDisksByPodUID 는 주어진 파드 UID 의 장치별로 가장 최근의 2개의 디스크 메트릭을 조회합니다.
+func (r *podRepository) FindDisksByPodUID(podUID string) ([]*entity.PodDiskMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY device ORDER BY timestamp DESC) AS rn
+			FROM pod_disk_metrics
+			WHERE pod_uid = $1
+		)
+		SELECT ` + podDiskMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY device, timestamp DESC;
+	`
+
+	var metrics []*entity.PodDiskMetrics
+	err := r.db.Select(&metrics, query, podUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindInterfacesByPodUID 는 주어진 파드 UID 의 인터페이스별로 가장 최근의 2개의 네트워크 메트릭을 조회합니다.
+func (r *podRepository) FindInterfacesByPodUID(podUID string) ([]*entity.PodInterfaceMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				*,
+				ROW_NUMBER() OVER (PARTITION BY interface_name ORDER BY timestamp DESC) AS rn
+			FROM pod_interface_metrics
+			WHERE pod_uid = $1
+		)
+		SELECT ` + podInterfaceMetricsColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY interface_name, timestamp DESC;
+	`
+
+	var metrics []*entity.PodInterfaceMetrics
+	err := r.db.Select(&metrics, query, podUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
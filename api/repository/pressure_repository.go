@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+// pressureColumns 는 entity.PressureMetrics 에 매핑되는 컬럼 목록입니다.
+// boot_id, uptime_seconds 는 같은 시각에 수집된 node_metrics 에서 가져옵니다.
+const pressureColumns = `
+			timestamp, resource, some_avg10, some_avg60, some_avg300, some_total,
+			full_avg10, full_avg60, full_avg300, full_total, boot_id, uptime_seconds`
+
+type PressureRepository interface {
+	FindByNodeName(nodeName string) ([]*entity.NodePressureMetrics, error)
+	FindByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodePressureMetrics, error)
+	FindByPodName(podName string) ([]*entity.PodPressureMetrics, error)
+	FindByPodNameInTimeWindow(podName string, startTime, endTime time.Time) ([]*entity.PodPressureMetrics, error)
+}
+
+type pressureRepository struct {
+	db *sqlx.DB
+}
+
+func NewPressureRepository(db *sqlx.DB) PressureRepository {
+	return &pressureRepository{
+		db: db,
+	}
+}
+
+// FindByNodeName 은 주어진 노드명의 자원별로 가장 최근의 2개의 PSI 메트릭을 조회합니다.
+func (r *pressureRepository) FindByNodeName(nodeName string) ([]*entity.NodePressureMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				p.*, n.boot_id, n.uptime_seconds,
+				ROW_NUMBER() OVER (PARTITION BY p.resource ORDER BY p.timestamp DESC) AS rn
+			FROM node_pressure_metrics p
+			LEFT JOIN node_metrics n ON n.node_name = p.node_name AND n.timestamp = p.timestamp
+			WHERE p.node_name = $1
+		)
+		SELECT id, node_name, ` + pressureColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY resource, timestamp DESC;
+	`
+
+	var metrics []*entity.NodePressureMetrics
+	err := r.db.Select(&metrics, query, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindByNodeNameInTimeWindow 는 주어진 노드명과 시간 범위에 대한 PSI 메트릭을 조회합니다.
+func (r *pressureRepository) FindByNodeNameInTimeWindow(nodeName string, startTime, endTime time.Time) ([]*entity.NodePressureMetrics, error) {
+	query := `
+		WITH joined AS (
+			SELECT p.*, n.boot_id, n.uptime_seconds
+			FROM node_pressure_metrics p
+			LEFT JOIN node_metrics n ON n.node_name = p.node_name AND n.timestamp = p.timestamp
+			WHERE p.node_name = $1
+			  AND p.timestamp >= $2
+			  AND p.timestamp <= $3
+		)
+		SELECT id, node_name, ` + pressureColumns + `
+		FROM joined
+		ORDER BY resource, timestamp DESC;
+	`
+
+	var metrics []*entity.NodePressureMetrics
+	err := r.db.Select(&metrics, query, nodeName, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindByPodName 은 주어진 파드명의 자원별로 가장 최근의 2개의 PSI 메트릭을 조회합니다.
+func (r *pressureRepository) FindByPodName(podName string) ([]*entity.PodPressureMetrics, error) {
+	query := `
+		WITH ranked AS (
+			SELECT
+				p.*, n.boot_id, n.uptime_seconds,
+				ROW_NUMBER() OVER (PARTITION BY p.resource ORDER BY p.timestamp DESC) AS rn
+			FROM pod_pressure_metrics p
+			LEFT JOIN node_metrics n ON n.node_name = p.node_name AND n.timestamp = p.timestamp
+			WHERE p.pod_name = $1
+		)
+		SELECT id, pod_name, pod_uid, namespace_name, node_name, ` + pressureColumns + `
+		FROM ranked
+		WHERE rn <= 2
+		ORDER BY resource, timestamp DESC;
+	`
+
+	var metrics []*entity.PodPressureMetrics
+	err := r.db.Select(&metrics, query, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// FindByPodNameInTimeWindow 는 주어진 파드명과 시간 범위에 대한 PSI 메트릭을 조회합니다.
+func (r *pressureRepository) FindByPodNameInTimeWindow(podName string, startTime, endTime time.Time) ([]*entity.PodPressureMetrics, error) {
+	query := `
+		WITH joined AS (
+			SELECT p.*, n.boot_id, n.uptime_seconds
+			FROM pod_pressure_metrics p
+			LEFT JOIN node_metrics n ON n.node_name = p.node_name AND n.timestamp = p.timestamp
+			WHERE p.pod_name = $1
+			  AND p.timestamp >= $2
+			  AND p.timestamp <= $3
+		)
+		SELECT id, pod_name, pod_uid, namespace_name, node_name, ` + pressureColumns + `
+		FROM joined
+		ORDER BY resource, timestamp DESC;
+	`
+
+	var metrics []*entity.PodPressureMetrics
+	err := r.db.Select(&metrics, query, podName, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
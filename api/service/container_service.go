@@ -0,0 +1,117 @@
+package service
+
+import (
+	"log/slog"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/repository"
+)
+
+type ContainerService interface {
+	FindByPodName(podName string) ([]*dto.ContainerMetricsResponse, error)
+}
+
+type containerService struct {
+	containerRepository repository.ContainerRepository
+}
+
+func NewContainerService(containerRepository repository.ContainerRepository) ContainerService {
+	return &containerService{
+		containerRepository: containerRepository,
+	}
+}
+
+// FindByPodName 는 주어진 파드명에 대해 컨테이너별 최신 메트릭을 제공합니다.
+func (s *containerService) FindByPodName(podName string) ([]*dto.ContainerMetricsResponse, error) {
+	// 파드의 컨테이너들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
+	metrics, err := s.containerRepository.FindByPodName(podName)
+	if err != nil {
+		slog.Error("failed to get container metrics by pod name", "podName", podName, "error", err)
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	// 컨테이너 ID별로 메트릭을 그룹화합니다.
+	metricsMap := make(map[string][]*entity.ContainerMetrics)
+	for _, metric := range metrics {
+		metricsMap[metric.ContainerID] = append(metricsMap[metric.ContainerID], metric)
+	}
+
+	// 재시작 등으로 사라진 컨테이너를 제외하기 위해 가장 최근 수집 시각을 구합니다.
+	latestTimestamp := metrics[0].Timestamp
+	for _, metric := range metrics {
+		if metric.Timestamp.After(latestTimestamp) {
+			latestTimestamp = metric.Timestamp
+		}
+	}
+
+	// 각 컨테이너에 대해 가장 최근의 2개의 메트릭을 비교하여 응답을 생성합니다.
+	var responses []*dto.ContainerMetricsResponse
+	for _, containerMetrics := range metricsMap {
+		if len(containerMetrics) < 2 {
+			continue // 최소 2개의 메트릭이 있어야 비교 가능
+		}
+
+		latest := containerMetrics[0]
+		previous := containerMetrics[1]
+
+		if latest.Timestamp.Before(latestTimestamp) {
+			continue // 최근 수집에 포함되지 않은 컨테이너
+		}
+
+		response := &dto.ContainerMetricsResponse{
+			Timestamp:        latest.Timestamp,
+			ContainerID:      latest.ContainerID,
+			ContainerName:    latest.ContainerName,
+			Image:            latest.Image,
+			RestartCount:     latest.RestartCount,
+			PodName:          latest.PodName,
+			PodUID:           latest.PodUID,
+			NamespaceName:    latest.NamespaceName,
+			NodeName:         latest.NodeName,
+			CpuMillicores:    calculateContainerCpuMillicores(latest, previous),
+			CpuThrottleRatio: calculateContainerThrottleRatio(latest, previous),
+			MemoryBytes:      latest.MemoryUsage,
+			DiskReadBytes:    latest.DiskReadBytes,
+			DiskWriteBytes:   latest.DiskWriteBytes,
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+// calculateContainerCpuMillicores 는 이전 메트릭과 최신 메트릭을 비교하여 컨테이너의 CPU 밀리코어를 계산합니다.
+func calculateContainerCpuMillicores(latest, previous *entity.ContainerMetrics) float64 {
+	if latest == nil || previous == nil {
+		return 0.0
+	}
+
+	deltaCpuUsage := latest.CPUUsageUsec - previous.CPUUsageUsec
+	interval := latest.Timestamp.Sub(previous.Timestamp).Seconds()
+
+	if interval <= 0 {
+		return 0.0
+	}
+
+	return float64(deltaCpuUsage) / (interval * 1e3)
+}
+
+// calculateContainerThrottleRatio 는 이전 메트릭과 최신 메트릭을 비교하여 컨테이너의 CPU 스로틀링 비율을 계산합니다.
+func calculateContainerThrottleRatio(latest, previous *entity.ContainerMetrics) float64 {
+	if latest == nil || previous == nil {
+		return 0.0
+	}
+
+	deltaPeriods := latest.CPUNrPeriods - previous.CPUNrPeriods
+	deltaThrottled := latest.CPUNrThrottled - previous.CPUNrThrottled
+	if deltaPeriods <= 0 || deltaThrottled < 0 {
+		return 0.0
+	}
+
+	return calculateThrottleRatio(deltaPeriods, deltaThrottled)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+)
+
+// counterReset 은 두 노드 메트릭 사이에 노드가 재부팅되어 누적 카운터가 초기화되었는지를 나타냅니다.
+// 재부팅된 경우 카운터는 부팅 시점부터 다시 누적되므로, 최신 값 전체를 가동 시간 동안의 증가량으로 간주합니다.
+type counterReset struct {
+	rebooted bool
+	uptime   time.Duration
+}
+
+// newCounterReset 은 두 노드 메트릭의 boot ID 를 비교하여 counterReset 을 생성합니다.
+// boot ID 가 없는 메트릭(수집 실패 또는 boot ID 저장 이전)은 재부팅되지 않은 것으로 간주합니다.
+func newCounterReset(latest, previous *entity.NodeMetrics) counterReset {
+	if latest == nil || previous == nil {
+		return counterReset{}
+	}
+	return newBootCounterReset(latest.BootID, previous.BootID, latest.UptimeSeconds)
+}
+
+// newPressureCounterReset 은 두 PSI 메트릭과 같은 시각에 수집된 노드의 boot ID 를 비교하여 counterReset 을 생성합니다.
+func newPressureCounterReset(latest, previous *entity.PressureMetrics) counterReset {
+	return newBootCounterReset(latest.BootID, previous.BootID, latest.UptimeSeconds)
+}
+
+// newBootCounterReset 은 최신, 이전 메트릭의 boot ID 와 최신 메트릭의 가동 시간으로 counterReset 을 생성합니다.
+func newBootCounterReset(latestBootID, previousBootID sql.NullString, latestUptimeSeconds sql.NullInt64) counterReset {
+	if !latestBootID.Valid || !previousBootID.Valid || latestBootID.String == "" || previousBootID.String == "" {
+		return counterReset{}
+	}
+	if latestBootID.String == previousBootID.String {
+		return counterReset{}
+	}
+
+	reset := counterReset{rebooted: true}
+	if latestUptimeSeconds.Valid {
+		reset.uptime = time.Duration(latestUptimeSeconds.Int64) * time.Second
+	}
+	return reset
+}
+
+// interval 은 누적 카운터가 증가한 시간 구간입니다.
+// 재부팅된 경우 두 메트릭 사이의 간격과 가동 시간 중 짧은 쪽을 사용합니다.
+func (r counterReset) interval(interval time.Duration) time.Duration {
+	if r.rebooted && r.uptime > 0 && r.uptime < interval {
+		return r.uptime
+	}
+	return interval
+}
+
+// delta 는 누적 카운터의 증가량을 계산합니다.
+// 재부팅된 경우 이전 값 대신 0 을 기준으로 계산하며, 카운터가 감소한 경우 0 을 반환합니다.
+func (r counterReset) delta(latest, previous int64) int64 {
+	if r.rebooted {
+		previous = 0
+	}
+	return max(latest-previous, 0)
+}
+
+// rate 는 누적 카운터의 초당 증가량을 계산합니다.
+// 재부팅된 경우 이전 값 대신 0 을 기준으로 계산합니다.
+func (r counterReset) rate(latest, previous int64, interval time.Duration) float64 {
+	if r.rebooted {
+		previous = 0
+	}
+	return calculateRate(latest, previous, r.interval(interval))
+}
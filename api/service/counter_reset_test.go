@@ -0,0 +1,83 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+)
+
+func nodeMetricsWithBoot(bootID string, uptimeSeconds int64) *entity.NodeMetrics {
+	return &entity.NodeMetrics{
+		BootID:        sql.NullString{String: bootID, Valid: bootID != ""},
+		UptimeSeconds: sql.NullInt64{Int64: uptimeSeconds, Valid: uptimeSeconds > 0},
+	}
+}
+
+func TestNewCounterReset(t *testing.T) {
+	tests := []struct {
+		name     string
+		latest   *entity.NodeMetrics
+		previous *entity.NodeMetrics
+		want     counterReset
+	}{
+		{"same boot", nodeMetricsWithBoot("a", 120), nodeMetricsWithBoot("a", 60), counterReset{}},
+		{"rebooted", nodeMetricsWithBoot("b", 30), nodeMetricsWithBoot("a", 600), counterReset{rebooted: true, uptime: 30 * time.Second}},
+		{"rebooted without uptime", nodeMetricsWithBoot("b", 0), nodeMetricsWithBoot("a", 600), counterReset{rebooted: true}},
+		{"latest boot id missing", nodeMetricsWithBoot("", 30), nodeMetricsWithBoot("a", 600), counterReset{}},
+		{"previous boot id missing", nodeMetricsWithBoot("b", 30), nodeMetricsWithBoot("", 600), counterReset{}},
+		{"previous missing", nodeMetricsWithBoot("b", 30), nil, counterReset{}},
+	}
+
+	for _, tt := range tests {
+		if got := newCounterReset(tt.latest, tt.previous); got != tt.want {
+			t.Errorf("%s: newCounterReset() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCounterResetRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		reset    counterReset
+		latest   int64
+		previous int64
+		interval time.Duration
+		want     float64
+	}{
+		{"same boot", counterReset{}, 1500, 1000, 10 * time.Second, 50},
+		{"rebooted within interval", counterReset{rebooted: true, uptime: 5 * time.Second}, 100, 1000, 10 * time.Second, 20},
+		{"rebooted without uptime", counterReset{rebooted: true}, 100, 1000, 10 * time.Second, 10},
+		{"rebooted uptime longer than interval", counterReset{rebooted: true, uptime: time.Minute}, 100, 1000, 10 * time.Second, 10},
+		{"counter decreased", counterReset{}, 900, 1000, 10 * time.Second, 0},
+		{"zero interval", counterReset{}, 1500, 1000, 0, 0},
+		{"rebooted zero interval", counterReset{rebooted: true, uptime: 5 * time.Second}, 100, 1000, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reset.rate(tt.latest, tt.previous, tt.interval); got != tt.want {
+			t.Errorf("%s: rate(%d, %d, %s) = %v, want %v", tt.name, tt.latest, tt.previous, tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestCounterResetDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		reset    counterReset
+		latest   int64
+		previous int64
+		want     int64
+	}{
+		{"same boot", counterReset{}, 1500, 1000, 500},
+		{"rebooted", counterReset{rebooted: true, uptime: 5 * time.Second}, 100, 1000, 100},
+		{"counter decreased", counterReset{}, 900, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reset.delta(tt.latest, tt.previous); got != tt.want {
+			t.Errorf("%s: delta(%d, %d) = %d, want %d", tt.name, tt.latest, tt.previous, got, tt.want)
+		}
+	}
+}
@@ -7,12 +7,13 @@ import (
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/repository"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
 type DeploymentService interface {
-	FindByNamespaceName(namespaceName string) ([]*dto.DeploymentMetricsResponse, error)
-	FindByDeploymentName(namespaceName, deploymentName string) (*dto.DeploymentMetricsResponse, error)
-	FindPodsByDeploymentName(namespaceName, deploymentName string) ([]*dto.PodMetricsResponse, error)
+	FindByNamespaceName(namespaceName string, memory utils.MemoryFlavor) ([]*dto.DeploymentMetricsResponse, error)
+	FindByDeploymentName(namespaceName, deploymentName string, memory utils.MemoryFlavor) (*dto.DeploymentMetricsResponse, error)
+	FindPodsByDeploymentName(namespaceName, deploymentName string, memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error)
 }
 
 type deploymentService struct {
@@ -26,7 +27,7 @@ func NewDeploymentService(deploymentRepository repository.DeploymentRepository)
 }
 
 // FindByNamespaceName 는 주어진 네임스페이스의 모든 디플로이먼트에 대해 집계된 메트릭을 제공합니다.
-func (s *deploymentService) FindByNamespaceName(namespaceName string) ([]*dto.DeploymentMetricsResponse, error) {
+func (s *deploymentService) FindByNamespaceName(namespaceName string, memory utils.MemoryFlavor) ([]*dto.DeploymentMetricsResponse, error) {
 	// 특정 네임스페이스의 디플로이먼트 파드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	allPodMetrics, err := s.deploymentRepository.FindByNamespaceName(namespaceName)
 	if err != nil {
@@ -48,7 +49,7 @@ func (s *deploymentService) FindByNamespaceName(namespaceName string) ([]*dto.De
 	// 각 디플로이먼트에 대해 집계 계산을 수행합니다.
 	var responses []*dto.DeploymentMetricsResponse
 	for deploymentName, podMetrics := range deploymentMetricsMap {
-		aggregatedMetrics := calculateDeploymentMetrics(namespaceName, deploymentName, podMetrics)
+		aggregatedMetrics := calculateDeploymentMetrics(namespaceName, deploymentName, podMetrics, memory)
 		if aggregatedMetrics != nil {
 			responses = append(responses, aggregatedMetrics)
 		}
@@ -58,7 +59,7 @@ func (s *deploymentService) FindByNamespaceName(namespaceName string) ([]*dto.De
 }
 
 // FindByDeploymentName 는 주어진 디플로이먼트에 대해 집계된 메트릭을 제공합니다.
-func (s *deploymentService) FindByDeploymentName(namespaceName, deploymentName string) (*dto.DeploymentMetricsResponse, error) {
+func (s *deploymentService) FindByDeploymentName(namespaceName, deploymentName string, memory utils.MemoryFlavor) (*dto.DeploymentMetricsResponse, error) {
 	// 특정 디플로이먼트의 파드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	podMetrics, err := s.deploymentRepository.FindByDeploymentName(namespaceName, deploymentName)
 	if err != nil {
@@ -70,12 +71,12 @@ func (s *deploymentService) FindByDeploymentName(namespaceName, deploymentName s
 	}
 
 	// 디플로이먼트 집계 계산을 수행합니다.
-	aggregatedMetrics := calculateDeploymentMetrics(namespaceName, deploymentName, podMetrics)
+	aggregatedMetrics := calculateDeploymentMetrics(namespaceName, deploymentName, podMetrics, memory)
 	return aggregatedMetrics, nil
 }
 
 // FindPodsByDeploymentName 는 주어진 디플로이먼트의 모든 파드에 대해 최신 메트릭을 제공합니다.
-func (s *deploymentService) FindPodsByDeploymentName(namespaceName, deploymentName string) ([]*dto.PodMetricsResponse, error) {
+func (s *deploymentService) FindPodsByDeploymentName(namespaceName, deploymentName string, memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error) {
 	// 주어진 디플로이먼트의 모든 파드에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	metrics, err := s.deploymentRepository.FindByDeploymentName(namespaceName, deploymentName)
 	if err != nil {
@@ -102,36 +103,14 @@ func (s *deploymentService) FindPodsByDeploymentName(namespaceName, deploymentNa
 		latest := podMetrics[0]
 		previous := podMetrics[1]
 
-		cpuMillicores := calculatePodCpuMillicores(latest, previous)
-
-		var deploymentName *string
-		if latest.DeploymentName.Valid {
-			deploymentName = &latest.DeploymentName.String
-		}
-
-		response := &dto.PodMetricsResponse{
-			Timestamp:      latest.Timestamp,
-			PodName:        latest.PodName,
-			DeploymentName: deploymentName,
-			NamespaceName:  latest.NamespaceName,
-			NodeName:       latest.NodeName,
-			UID:            latest.UID,
-			CpuMillicores:  cpuMillicores,
-			MemoryBytes:    latest.MemoryUsage,
-			DiskReadBytes:  latest.DiskReadBytes,
-			DiskWriteBytes: latest.DiskWriteBytes,
-			NetworkRxBytes: latest.NetworkRxBytes,
-			NetworkTxBytes: latest.NetworkTxBytes,
-		}
-
-		responses = append(responses, response)
+		responses = append(responses, newPodMetricsResponse(latest, previous, memory))
 	}
 
 	return responses, nil
 }
 
 // calculateDeploymentMetrics 는 디플로이먼트의 파드 메트릭들을 집계하여 디플로이먼트 메트릭을 계산합니다.
-func calculateDeploymentMetrics(namespaceName, deploymentName string, podMetrics []*entity.PodMetrics) *dto.DeploymentMetricsResponse {
+func calculateDeploymentMetrics(namespaceName, deploymentName string, podMetrics []*entity.PodMetrics, memory utils.MemoryFlavor) *dto.DeploymentMetricsResponse {
 	if len(podMetrics) == 0 {
 		return nil
 	}
@@ -143,13 +122,18 @@ func calculateDeploymentMetrics(namespaceName, deploymentName string, podMetrics
 	}
 
 	var totalCpuMillicores float64
+	var totalCpuPeriods int64
+	var totalCpuThrottled int64
 	var totalMemoryBytes int64
 	var totalDiskReadBytes int64
 	var totalDiskWriteBytes int64
 	var totalNetworkRxBytes int64
 	var totalNetworkTxBytes int64
+	var totalPids int64
+	var totalOpenFds int64
 	var latestTimestamp time.Time
 	var activePodCount int
+	var hostNetworkPodCount int
 
 	// 각 파드에 대해 메트릭을 계산하고 집계합니다.
 	for _, podMetricsSlice := range podMetricsMap {
@@ -164,12 +148,23 @@ func calculateDeploymentMetrics(namespaceName, deploymentName string, podMetrics
 		cpuMillicores := calculatePodCpuMillicores(latest, previous)
 		totalCpuMillicores += cpuMillicores
 
+		// CPU 스로틀링 주기 집계
+		periods, throttled := calculatePodThrottleDelta(latest, previous)
+		totalCpuPeriods += periods
+		totalCpuThrottled += throttled
+
 		// 최신 메트릭 값들을 집계
-		totalMemoryBytes += latest.MemoryUsage
+		totalMemoryBytes += selectPodMemoryBytes(latest, memory)
 		totalDiskReadBytes += latest.DiskReadBytes
 		totalDiskWriteBytes += latest.DiskWriteBytes
-		totalNetworkRxBytes += latest.NetworkRxBytes
-		totalNetworkTxBytes += latest.NetworkTxBytes
+		if includeInNetworkTotals(latest) {
+			totalNetworkRxBytes += latest.NetworkRxBytes
+			totalNetworkTxBytes += latest.NetworkTxBytes
+		} else {
+			hostNetworkPodCount++
+		}
+		totalPids += latest.PidsCurrent.Int64 // 수집 실패(NULL) 시 0
+		totalOpenFds += latest.OpenFds.Int64
 
 		// 최신 타임스탬프 추적
 		if latest.Timestamp.After(latestTimestamp) {
@@ -181,15 +176,19 @@ func calculateDeploymentMetrics(namespaceName, deploymentName string, podMetrics
 
 	// 디플로이먼트 메트릭 응답 생성
 	return &dto.DeploymentMetricsResponse{
-		DeploymentName: deploymentName,
-		NamespaceName:  namespaceName,
-		Timestamp:      latestTimestamp,
-		CpuMillicores:  totalCpuMillicores,
-		MemoryBytes:    totalMemoryBytes,
-		DiskReadBytes:  totalDiskReadBytes,
-		DiskWriteBytes: totalDiskWriteBytes,
-		NetworkRxBytes: totalNetworkRxBytes,
-		NetworkTxBytes: totalNetworkTxBytes,
-		PodCount:       activePodCount,
+		DeploymentName:   deploymentName,
+		NamespaceName:    namespaceName,
+		Timestamp:        latestTimestamp,
+		CpuMillicores:    totalCpuMillicores,
+		CpuThrottleRatio: calculateThrottleRatio(totalCpuPeriods, totalCpuThrottled),
+		MemoryBytes:      totalMemoryBytes,
+		DiskReadBytes:    totalDiskReadBytes,
+		DiskWriteBytes:   totalDiskWriteBytes,
+		NetworkRxBytes:   totalNetworkRxBytes,
+		NetworkTxBytes:   totalNetworkTxBytes,
+		Pids:             totalPids,
+		OpenFds:          totalOpenFds,
+		PodCount:         activePodCount,
+		HostNetworkPods:  hostNetworkPodCount,
 	}
 }
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+)
+
+// diskIoDelta 는 블록 장치의 누적 카운터가 일정 시간 동안 증가한 양입니다.
+// 여러 구간의 증가량을 더한 뒤 비율을 계산하면 구간별 비율의 평균보다 I/O 가 몰린 구간이 정확히 반영됩니다.
+type diskIoDelta struct {
+	interval       time.Duration
+	readBytes      int64
+	writeBytes     int64
+	reads          int64
+	writes         int64
+	mergedReads    int64
+	mergedWrites   int64
+	readTime       int64 // ms
+	writeTime      int64 // ms
+	ioTime         int64 // ms
+	weightedIoTime int64 // ms
+}
+
+// newDiskIoDelta 는 같은 장치의 두 디스크 메트릭 사이의 증가량을 계산합니다.
+// 노드가 재부팅된 경우 부팅 이후의 누적 값을 증가량으로 사용합니다.
+func newDiskIoDelta(latest, previous *entity.NodeDiskMetrics, reset counterReset) diskIoDelta {
+	interval := reset.interval(latest.Timestamp.Sub(previous.Timestamp))
+	if reset.rebooted {
+		previous = &entity.NodeDiskMetrics{}
+	}
+
+	return diskIoDelta{
+		interval:       interval,
+		readBytes:      counterDelta(latest.ReadBytes, previous.ReadBytes),
+		writeBytes:     counterDelta(latest.WriteBytes, previous.WriteBytes),
+		reads:          counterDelta(latest.ReadCount, previous.ReadCount),
+		writes:         counterDelta(latest.WriteCount, previous.WriteCount),
+		mergedReads:    counterDelta(latest.MergedReadCount, previous.MergedReadCount),
+		mergedWrites:   counterDelta(latest.MergedWriteCount, previous.MergedWriteCount),
+		readTime:       counterDelta(latest.ReadTime, previous.ReadTime),
+		writeTime:      counterDelta(latest.WriteTime, previous.WriteTime),
+		ioTime:         counterDelta(latest.IoTime, previous.IoTime),
+		weightedIoTime: counterDelta(latest.WeightedIoTime, previous.WeightedIoTime),
+	}
+}
+
+// add 는 다른 구간의 증가량을 더합니다.
+func (d *diskIoDelta) add(o diskIoDelta) {
+	d.interval += o.interval
+	d.readBytes += o.readBytes
+	d.writeBytes += o.writeBytes
+	d.reads += o.reads
+	d.writes += o.writes
+	d.mergedReads += o.mergedReads
+	d.mergedWrites += o.mergedWrites
+	d.readTime += o.readTime
+	d.writeTime += o.writeTime
+	d.ioTime += o.ioTime
+	d.weightedIoTime += o.weightedIoTime
+}
+
+// perSecond 는 증가량을 초당 값으로 환산합니다.
+func (d diskIoDelta) perSecond(delta int64) float64 {
+	seconds := d.interval.Seconds()
+	if seconds <= 0 {
+		return 0.0
+	}
+	return float64(delta) / seconds
+}
+
+// readLatencyMs 는 완료된 읽기 요청 하나의 평균 처리 시간(ms)입니다.
+func (d diskIoDelta) readLatencyMs() float64 {
+	if d.reads <= 0 {
+		return 0.0
+	}
+	return float64(d.readTime) / float64(d.reads)
+}
+
+// writeLatencyMs 는 완료된 쓰기 요청 하나의 평균 처리 시간(ms)입니다.
+func (d diskIoDelta) writeLatencyMs() float64 {
+	if d.writes <= 0 {
+		return 0.0
+	}
+	return float64(d.writeTime) / float64(d.writes)
+}
+
+// utilPercent 는 장치가 I/O 를 처리하고 있던 시간의 비율입니다.
+// 병렬로 요청을 처리하는 장치(SSD, NVMe)에서는 100% 가 곧 포화를 뜻하지는 않으므로 queueDepth 와 함께 봐야 합니다.
+func (d diskIoDelta) utilPercent() float64 {
+	util := d.perSecond(d.ioTime) / 10 // ms/sec -> %
+	if util > 100 {
+		return 100
+	}
+	return util
+}
+
+// queueDepth 는 구간 동안 처리 중이거나 대기 중이던 평균 요청 수입니다.
+func (d diskIoDelta) queueDepth() float64 {
+	return d.perSecond(d.weightedIoTime) / 1000
+}
+
+// counterDelta 는 누적 카운터의 증가량입니다.
+// 장치가 교체되는 등으로 카운터가 감소한 경우 0 으로 처리합니다.
+func counterDelta(latest, previous int64) int64 {
+	if latest < previous {
+		return 0
+	}
+	return latest - previous
+}
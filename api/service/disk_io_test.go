@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+)
+
+func TestNewDiskIoDelta(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := &entity.NodeDiskMetrics{
+		Timestamp:  base,
+		ReadBytes:  4096,
+		WriteBytes: 8192,
+		ReadCount:  10,
+		WriteCount: 20,
+		ReadTime:   50,
+		IoTime:     1000,
+	}
+
+	tests := []struct {
+		name   string
+		latest *entity.NodeDiskMetrics
+		reset  counterReset
+		want   diskIoDelta
+	}{
+		{
+			name: "same boot",
+			latest: &entity.NodeDiskMetrics{
+				Timestamp: base.Add(10 * time.Second), ReadBytes: 14336, WriteBytes: 8192,
+				ReadCount: 14, WriteCount: 20, ReadTime: 70, IoTime: 3000,
+			},
+			want: diskIoDelta{interval: 10 * time.Second, readBytes: 10240, reads: 4, readTime: 20, ioTime: 2000},
+		},
+		{
+			name: "rebooted",
+			latest: &entity.NodeDiskMetrics{
+				Timestamp: base.Add(10 * time.Second), ReadBytes: 1024, WriteBytes: 2048,
+				ReadCount: 1, WriteCount: 2, ReadTime: 5, IoTime: 100,
+			},
+			reset: counterReset{rebooted: true, uptime: 4 * time.Second},
+			want: diskIoDelta{
+				interval: 4 * time.Second, readBytes: 1024, writeBytes: 2048,
+				reads: 1, writes: 2, readTime: 5, ioTime: 100,
+			},
+		},
+		{
+			name: "counter decreased",
+			latest: &entity.NodeDiskMetrics{
+				Timestamp: base.Add(10 * time.Second), ReadBytes: 1024, WriteBytes: 8192,
+				ReadCount: 12, WriteCount: 20, ReadTime: 50, IoTime: 900,
+			},
+			want: diskIoDelta{interval: 10 * time.Second, reads: 2},
+		},
+		{
+			name: "zero interval",
+			latest: &entity.NodeDiskMetrics{
+				Timestamp: base, ReadBytes: 4096, WriteBytes: 8192,
+				ReadCount: 10, WriteCount: 20, ReadTime: 50, IoTime: 1000,
+			},
+			want: diskIoDelta{},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := newDiskIoDelta(tt.latest, previous, tt.reset); got != tt.want {
+			t.Errorf("%s: newDiskIoDelta() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiskIoDeltaZeroInterval(t *testing.T) {
+	d := diskIoDelta{readBytes: 4096, reads: 2, readTime: 10, ioTime: 500, weightedIoTime: 1000}
+
+	if got := d.perSecond(d.readBytes); got != 0 {
+		t.Errorf("perSecond() = %v, want 0", got)
+	}
+	if got := d.utilPercent(); got != 0 {
+		t.Errorf("utilPercent() = %v, want 0", got)
+	}
+	if got := d.queueDepth(); got != 0 {
+		t.Errorf("queueDepth() = %v, want 0", got)
+	}
+	if got := d.readLatencyMs(); got != 5 {
+		t.Errorf("readLatencyMs() = %v, want 5", got)
+	}
+	if got := d.writeLatencyMs(); got != 0 {
+		t.Errorf("writeLatencyMs() = %v, want 0", got)
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/repository"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
+)
+
+type EventService interface {
+	FindOomEventsByNamespaceName(namespaceName, window string) ([]*dto.PodEventResponse, error)
+}
+
+type eventService struct {
+	eventRepository repository.EventRepository
+}
+
+func NewEventService(eventRepository repository.EventRepository) EventService {
+	return &eventService{
+		eventRepository: eventRepository,
+	}
+}
+
+// FindOomEventsByNamespaceName 는 주어진 네임스페이스에서 윈도우 동안 발생한 OOM 이벤트 목록을 제공합니다.
+func (s *eventService) FindOomEventsByNamespaceName(namespaceName, window string) ([]*dto.PodEventResponse, error) {
+	// 윈도우 파라미터 파싱
+	windowSpec, err := utils.ParseWindow(window)
+	if err != nil {
+		slog.Error("failed to parse window parameter", "window", window, "error", err)
+		return nil, err
+	}
+
+	// 시간 범위 계산 (UTC 변환)
+	endTime := time.Now().UTC()
+	startTime := windowSpec.GetStartTime(endTime)
+
+	events, err := s.eventRepository.FindOomEventsByNamespaceName(namespaceName, startTime, endTime)
+	if err != nil {
+		slog.Error("failed to get oom events by namespace name", "namespaceName", namespaceName, "startTime", startTime, "endTime", endTime, "error", err)
+		return nil, err
+	}
+
+	responses := []*dto.PodEventResponse{}
+	for _, event := range events {
+		responses = append(responses, &dto.PodEventResponse{
+			Timestamp:     event.Timestamp,
+			PodName:       event.PodName,
+			NamespaceName: event.NamespaceName,
+			NodeName:      event.NodeName,
+			UID:           event.PodUID,
+			EventType:     event.EventType,
+			Count:         event.Count,
+		})
+	}
+
+	return responses, nil
+}
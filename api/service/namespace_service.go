@@ -11,10 +11,10 @@ import (
 )
 
 type NamespaceService interface {
-	FindAll() ([]*dto.NamespaceMetricsResponse, error)
-	FindByNamespaceName(namespaceName string) (*dto.NamespaceMetricsResponse, error)
-	FindPodsByNamespaceName(namespaceName string) ([]*dto.PodMetricsResponse, error)
-	FindTimeSeriesByNamespaceName(namespaceName, window string) (*dto.NamespaceTimeSeriesResponse, error)
+	FindAll(memory utils.MemoryFlavor) ([]*dto.NamespaceMetricsResponse, error)
+	FindByNamespaceName(namespaceName string, memory utils.MemoryFlavor) (*dto.NamespaceMetricsResponse, error)
+	FindPodsByNamespaceName(namespaceName string, memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error)
+	FindTimeSeriesByNamespaceName(namespaceName, window string, memory utils.MemoryFlavor) (*dto.NamespaceTimeSeriesResponse, error)
 }
 
 type namespaceService struct {
@@ -30,7 +30,7 @@ func NewNamespaceService(namespaceRepository repository.NamespaceRepository) Nam
 }
 
 // FindAll 는 모든 네임스페이스들에 대해 집계된 메트릭을 제공합니다.
-func (s *namespaceService) FindAll() ([]*dto.NamespaceMetricsResponse, error) {
+func (s *namespaceService) FindAll(memory utils.MemoryFlavor) ([]*dto.NamespaceMetricsResponse, error) {
 	// 모든 파드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	allPodMetrics, err := s.namespaceRepository.FindAll()
 	if err != nil {
@@ -52,7 +52,7 @@ func (s *namespaceService) FindAll() ([]*dto.NamespaceMetricsResponse, error) {
 	// 각 네임스페이스에 대해 집계 계산을 수행합니다.
 	var responses []*dto.NamespaceMetricsResponse
 	for namespaceName, podMetrics := range namespaceMetricsMap {
-		aggregatedMetrics := calculateNamespaceMetrics(namespaceName, podMetrics)
+		aggregatedMetrics := calculateNamespaceMetrics(namespaceName, podMetrics, memory)
 		if aggregatedMetrics != nil {
 			responses = append(responses, aggregatedMetrics)
 		}
@@ -62,7 +62,7 @@ func (s *namespaceService) FindAll() ([]*dto.NamespaceMetricsResponse, error) {
 }
 
 // FindByNamespaceName 는 주어진 네임스페이스명에 대해 집계된 메트릭을 제공합니다.
-func (s *namespaceService) FindByNamespaceName(namespaceName string) (*dto.NamespaceMetricsResponse, error) {
+func (s *namespaceService) FindByNamespaceName(namespaceName string, memory utils.MemoryFlavor) (*dto.NamespaceMetricsResponse, error) {
 	// 특정 네임스페이스의 파드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	podMetrics, err := s.namespaceRepository.FindByNamespaceName(namespaceName)
 	if err != nil {
@@ -74,12 +74,12 @@ func (s *namespaceService) FindByNamespaceName(namespaceName string) (*dto.Names
 	}
 
 	// 네임스페이스 집계 계산을 수행합니다.
-	aggregatedMetrics := calculateNamespaceMetrics(namespaceName, podMetrics)
+	aggregatedMetrics := calculateNamespaceMetrics(namespaceName, podMetrics, memory)
 	return aggregatedMetrics, nil
 }
 
 // FindPodsByNamespaceName 는 주어진 네임스페이스명을 가진 모든 파드의 최신 메트릭을 제공합니다.
-func (s *namespaceService) FindPodsByNamespaceName(namespaceName string) ([]*dto.PodMetricsResponse, error) {
+func (s *namespaceService) FindPodsByNamespaceName(namespaceName string, memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error) {
 	// 주어진 네임스페이스명을 가지는 모든 파드에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	metrics, err := s.namespaceRepository.FindByNamespaceName(namespaceName)
 	if err != nil {
@@ -106,36 +106,14 @@ func (s *namespaceService) FindPodsByNamespaceName(namespaceName string) ([]*dto
 		latest := podMetrics[0]
 		previous := podMetrics[1]
 
-		cpuMillicores := calculatePodCpuMillicores(latest, previous)
-
-		var deploymentName *string
-		if latest.DeploymentName.Valid {
-			deploymentName = &latest.DeploymentName.String
-		}
-
-		response := &dto.PodMetricsResponse{
-			Timestamp:      latest.Timestamp,
-			PodName:        latest.PodName,
-			DeploymentName: deploymentName,
-			NamespaceName:  latest.NamespaceName,
-			NodeName:       latest.NodeName,
-			UID:            latest.UID,
-			CpuMillicores:  cpuMillicores,
-			MemoryBytes:    latest.MemoryUsage,
-			DiskReadBytes:  latest.DiskReadBytes,
-			DiskWriteBytes: latest.DiskWriteBytes,
-			NetworkRxBytes: latest.NetworkRxBytes,
-			NetworkTxBytes: latest.NetworkTxBytes,
-		}
-
-		responses = append(responses, response)
+		responses = append(responses, newPodMetricsResponse(latest, previous, memory))
 	}
 
 	return responses, nil
 }
 
 // calculateNamespaceMetrics 는 네임스페이스의 파드 메트릭들을 집계하여 네임스페이스 메트릭을 계산합니다.
-func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMetrics) *dto.NamespaceMetricsResponse {
+func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMetrics, memory utils.MemoryFlavor) *dto.NamespaceMetricsResponse {
 	if len(podMetrics) == 0 {
 		return nil
 	}
@@ -147,6 +125,8 @@ func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMet
 	}
 
 	var totalCpuMillicores float64
+	var totalCpuPeriods int64
+	var totalCpuThrottled int64
 	var totalMemoryBytes int64
 	var totalDiskReadBytes int64
 	var totalDiskWriteBytes int64
@@ -154,6 +134,7 @@ func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMet
 	var totalNetworkTxBytes int64
 	var latestTimestamp time.Time
 	var activePodCount int
+	var hostNetworkPodCount int
 
 	// 각 파드에 대해 메트릭을 계산하고 집계합니다.
 	for _, podMetricsSlice := range podMetricsMap {
@@ -168,12 +149,21 @@ func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMet
 		cpuMillicores := calculatePodCpuMillicores(latest, previous)
 		totalCpuMillicores += cpuMillicores
 
+		// CPU 스로틀링 주기 집계
+		periods, throttled := calculatePodThrottleDelta(latest, previous)
+		totalCpuPeriods += periods
+		totalCpuThrottled += throttled
+
 		// 최신 메트릭 값들을 집계
-		totalMemoryBytes += latest.MemoryUsage
+		totalMemoryBytes += selectPodMemoryBytes(latest, memory)
 		totalDiskReadBytes += latest.DiskReadBytes
 		totalDiskWriteBytes += latest.DiskWriteBytes
-		totalNetworkRxBytes += latest.NetworkRxBytes
-		totalNetworkTxBytes += latest.NetworkTxBytes
+		if includeInNetworkTotals(latest) {
+			totalNetworkRxBytes += latest.NetworkRxBytes
+			totalNetworkTxBytes += latest.NetworkTxBytes
+		} else {
+			hostNetworkPodCount++
+		}
 
 		// 최신 타임스탬프 추적
 		if latest.Timestamp.After(latestTimestamp) {
@@ -185,20 +175,22 @@ func calculateNamespaceMetrics(namespaceName string, podMetrics []*entity.PodMet
 
 	// 네임스페이스 메트릭 응답 생성
 	return &dto.NamespaceMetricsResponse{
-		NamespaceName:  namespaceName,
-		Timestamp:      latestTimestamp,
-		CpuMillicores:  totalCpuMillicores,
-		MemoryBytes:    totalMemoryBytes,
-		DiskReadBytes:  totalDiskReadBytes,
-		DiskWriteBytes: totalDiskWriteBytes,
-		NetworkRxBytes: totalNetworkRxBytes,
-		NetworkTxBytes: totalNetworkTxBytes,
-		PodCount:       activePodCount,
+		NamespaceName:    namespaceName,
+		Timestamp:        latestTimestamp,
+		CpuMillicores:    totalCpuMillicores,
+		CpuThrottleRatio: calculateThrottleRatio(totalCpuPeriods, totalCpuThrottled),
+		MemoryBytes:      totalMemoryBytes,
+		DiskReadBytes:    totalDiskReadBytes,
+		DiskWriteBytes:   totalDiskWriteBytes,
+		NetworkRxBytes:   totalNetworkRxBytes,
+		NetworkTxBytes:   totalNetworkTxBytes,
+		PodCount:         activePodCount,
+		HostNetworkPods:  hostNetworkPodCount,
 	}
 }
 
 // FindTimeSeriesByNamespaceName 는 주어진 네임스페이스명과 윈도우에 대해 시계열 메트릭을 제공합니다.
-func (s *namespaceService) FindTimeSeriesByNamespaceName(namespaceName, window string) (*dto.NamespaceTimeSeriesResponse, error) {
+func (s *namespaceService) FindTimeSeriesByNamespaceName(namespaceName, window string, memory utils.MemoryFlavor) (*dto.NamespaceTimeSeriesResponse, error) {
 	// 윈도우 파라미터 파싱
 	windowSpec, err := utils.ParseWindow(window)
 	if err != nil {
@@ -222,7 +214,7 @@ func (s *namespaceService) FindTimeSeriesByNamespaceName(namespaceName, window s
 	}
 
 	// 네임스페이스 시계열 계산
-	response, err := s.timeSeriesCalculator.CalculateNamespaceTimeSeries(namespaceName, metrics, windowSpec)
+	response, err := s.timeSeriesCalculator.CalculateNamespaceTimeSeries(namespaceName, metrics, windowSpec, memory)
 	if err != nil {
 		slog.Error("failed to calculate namespace time series", "namespaceName", namespaceName, "error", err)
 		return nil, err
@@ -10,9 +10,18 @@ import (
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
 )
 
+const (
+	// filesystemFullPercent 이상 사용 중인 파일시스템(용량 또는 inode)은 곧 가득 찰 것으로 판단합니다.
+	filesystemFullPercent = 90.0
+	// filesystemFullHorizon 이내에 가득 찰 것으로 예상되는 파일시스템도 곧 가득 찰 것으로 판단합니다.
+	filesystemFullHorizon = 24 * time.Hour
+	// filesystemGrowthWindow 는 사용량 증가 속도를 계산하는 시간 구간입니다.
+	filesystemGrowthWindow = time.Hour
+)
+
 type NodeService interface {
-	FindAll() ([]*dto.NodeMetricsResponse, error)
-	FindByNodeName(nodeName string) (*dto.NodeMetricsResponse, error)
+	FindAll(breakdown *utils.BreakdownSpec) ([]*dto.NodeMetricsResponse, error)
+	FindByNodeName(nodeName string, breakdown *utils.BreakdownSpec, detail *utils.DetailSpec) (*dto.NodeMetricsResponse, error)
 	FindTimeSeriesByNodeName(nodeName, window string) (*dto.NodeTimeSeriesResponse, error)
 }
 
@@ -29,7 +38,7 @@ func NewNodeService(nodeRepository repository.NodeRepository) NodeService {
 }
 
 // FindAll 는 모든 노드들에 대해 최신 메트릭을 제공합니다.
-func (s *nodeService) FindAll() ([]*dto.NodeMetricsResponse, error) {
+func (s *nodeService) FindAll(breakdown *utils.BreakdownSpec) ([]*dto.NodeMetricsResponse, error) {
 	// 모든 노드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	metrics, err := s.nodeRepository.FindAll()
 	if err != nil {
@@ -48,6 +57,7 @@ func (s *nodeService) FindAll() ([]*dto.NodeMetricsResponse, error) {
 
 	// 각 노드에 대해 가장 최근의 2개의 메트릭을 비교하여 응답을 생성합니다.
 	var responses []*dto.NodeMetricsResponse
+	resets := make(map[string]counterReset)
 	for _, nodeMetrics := range metricsMap {
 		if len(nodeMetrics) < 2 {
 			continue // 최소 2개의 메트릭이 있어야 비교 가능
@@ -58,11 +68,14 @@ func (s *nodeService) FindAll() ([]*dto.NodeMetricsResponse, error) {
 
 		cpuMillicores := calculateNodeCpuMillicores(latest, previous)
 		memoryBytes := latest.MemoryTotal - latest.MemoryAvailable
+		resets[latest.NodeName] = newCounterReset(latest, previous)
 
 		response := &dto.NodeMetricsResponse{
 			Timestamp:      latest.Timestamp,
 			NodeName:       latest.NodeName,
 			CpuMillicores:  cpuMillicores,
+			CpuModes:       buildNodeCpuModesResponse(latest, previous),
+			System:         buildNodeSystemResponse(latest, previous),
 			MemoryBytes:    memoryBytes,
 			DiskReadBytes:  latest.DiskReadBytes,
 			DiskWriteBytes: latest.DiskWriteBytes,
@@ -72,12 +85,82 @@ func (s *nodeService) FindAll() ([]*dto.NodeMetricsResponse, error) {
 
 		responses = append(responses, response)
 	}
+	if len(responses) == 0 {
+		return nil, nil
+	}
+
+	// 파일시스템 용량 메트릭을 노드별로 추가합니다.
+	endTime := time.Now().UTC()
+	filesystemMetrics, err := s.nodeRepository.FindAllFilesystems(endTime.Add(-filesystemGrowthWindow), endTime)
+	if err != nil {
+		slog.Error("failed to get node filesystem metrics list", "error", err)
+		return nil, err
+	}
+
+	filesystemMetricsMap := make(map[string][]*entity.NodeFilesystemMetrics)
+	for _, metric := range filesystemMetrics {
+		filesystemMetricsMap[metric.NodeName] = append(filesystemMetricsMap[metric.NodeName], metric)
+	}
+	for _, response := range responses {
+		response.Filesystems, response.DiskFullSoon = buildNodeFilesystemResponses(filesystemMetricsMap[response.NodeName])
+	}
+
+	// 장치별 디스크 메트릭을 요청한 경우 노드별로 추가합니다.
+	if breakdown.Device {
+		diskMetrics, err := s.nodeRepository.FindAllDisks()
+		if err != nil {
+			slog.Error("failed to get node disk metrics list", "error", err)
+			return nil, err
+		}
+
+		diskMetricsMap := make(map[string][]*entity.NodeDiskMetrics)
+		for _, metric := range diskMetrics {
+			diskMetricsMap[metric.NodeName] = append(diskMetricsMap[metric.NodeName], metric)
+		}
+		for _, response := range responses {
+			response.Disks = buildNodeDiskResponses(diskMetricsMap[response.NodeName], resets[response.NodeName])
+		}
+	}
+
+	// 인터페이스별 네트워크 메트릭을 요청한 경우 노드별로 추가합니다.
+	if breakdown.Interface {
+		interfaceMetrics, err := s.nodeRepository.FindAllInterfaces()
+		if err != nil {
+			slog.Error("failed to get node interface metrics list", "error", err)
+			return nil, err
+		}
+
+		interfaceMetricsMap := make(map[string][]*entity.NodeInterfaceMetrics)
+		for _, metric := range interfaceMetrics {
+			interfaceMetricsMap[metric.NodeName] = append(interfaceMetricsMap[metric.NodeName], metric)
+		}
+		for _, response := range responses {
+			response.Interfaces = buildNodeInterfaceResponses(interfaceMetricsMap[response.NodeName], resets[response.NodeName])
+		}
+	}
+
+	// 논리 CPU 별 사용률을 요청한 경우 노드별로 추가합니다.
+	if breakdown.CPU {
+		cpuMetrics, err := s.nodeRepository.FindAllCpus()
+		if err != nil {
+			slog.Error("failed to get node cpu metrics list", "error", err)
+			return nil, err
+		}
+
+		cpuMetricsMap := make(map[string][]*entity.NodeCpuMetrics)
+		for _, metric := range cpuMetrics {
+			cpuMetricsMap[metric.NodeName] = append(cpuMetricsMap[metric.NodeName], metric)
+		}
+		for _, response := range responses {
+			response.Cpus = buildNodeCpuCoreResponses(cpuMetricsMap[response.NodeName], resets[response.NodeName])
+		}
+	}
 
 	return responses, nil
 }
 
 // FindByNodeName 는 주어진 노드명에 대해 최신 메트릭을 제공합니다.
-func (s *nodeService) FindByNodeName(nodeName string) (*dto.NodeMetricsResponse, error) {
+func (s *nodeService) FindByNodeName(nodeName string, breakdown *utils.BreakdownSpec, detail *utils.DetailSpec) (*dto.NodeMetricsResponse, error) {
 	metrics, err := s.nodeRepository.FindByNodeName(nodeName)
 	if err != nil {
 		slog.Error("failed to get node metrics by node name", "nodeName", nodeName, "error", err)
@@ -97,6 +180,8 @@ func (s *nodeService) FindByNodeName(nodeName string) (*dto.NodeMetricsResponse,
 		Timestamp:      latest.Timestamp,
 		NodeName:       latest.NodeName,
 		CpuMillicores:  cpuMillicores,
+		CpuModes:       buildNodeCpuModesResponse(latest, previous),
+		System:         buildNodeSystemResponse(latest, previous),
 		MemoryBytes:    memoryBytes,
 		DiskReadBytes:  latest.DiskReadBytes,
 		DiskWriteBytes: latest.DiskWriteBytes,
@@ -104,6 +189,50 @@ func (s *nodeService) FindByNodeName(nodeName string) (*dto.NodeMetricsResponse,
 		NetworkTxBytes: latest.NetworkTxBytes,
 	}
 
+	// 메모리 세부 항목을 요청한 경우 추가합니다.
+	if detail.Memory {
+		response.Memory = newNodeMemoryResponse(latest)
+	}
+
+	// 파일시스템 용량 메트릭을 추가합니다.
+	endTime := time.Now().UTC()
+	filesystemMetrics, err := s.nodeRepository.FindFilesystemsByNodeName(nodeName, endTime.Add(-filesystemGrowthWindow), endTime)
+	if err != nil {
+		slog.Error("failed to get node filesystem metrics by node name", "nodeName", nodeName, "error", err)
+		return nil, err
+	}
+	response.Filesystems, response.DiskFullSoon = buildNodeFilesystemResponses(filesystemMetrics)
+
+	// 장치별 디스크 메트릭을 요청한 경우 추가합니다.
+	if breakdown.Device {
+		diskMetrics, err := s.nodeRepository.FindDisksByNodeName(nodeName)
+		if err != nil {
+			slog.Error("failed to get node disk metrics by node name", "nodeName", nodeName, "error", err)
+			return nil, err
+		}
+		response.Disks = buildNodeDiskResponses(diskMetrics, newCounterReset(latest, previous))
+	}
+
+	// 인터페이스별 네트워크 메트릭을 요청한 경우 추가합니다.
+	if breakdown.Interface {
+		interfaceMetrics, err := s.nodeRepository.FindInterfacesByNodeName(nodeName)
+		if err != nil {
+			slog.Error("failed to get node interface metrics by node name", "nodeName", nodeName, "error", err)
+			return nil, err
+		}
+		response.Interfaces = buildNodeInterfaceResponses(interfaceMetrics, newCounterReset(latest, previous))
+	}
+
+	// 논리 CPU 별 사용률을 요청한 경우 추가합니다.
+	if breakdown.CPU {
+		cpuMetrics, err := s.nodeRepository.FindCpusByNodeName(nodeName)
+		if err != nil {
+			slog.Error("failed to get node cpu metrics by node name", "nodeName", nodeName, "error", err)
+			return nil, err
+		}
+		response.Cpus = buildNodeCpuCoreResponses(cpuMetrics, newCounterReset(latest, previous))
+	}
+
 	return response, nil
 }
 
@@ -131,8 +260,14 @@ func (s *nodeService) FindTimeSeriesByNodeName(nodeName, window string) (*dto.No
 		return nil, nil
 	}
 
+	diskMetrics, err := s.nodeRepository.FindDisksByNodeNameInTimeWindow(nodeName, startTime, endTime)
+	if err != nil {
+		slog.Error("failed to get node disk metrics in time window", "nodeName", nodeName, "startTime", startTime, "endTime", endTime, "error", err)
+		return nil, err
+	}
+
 	// 시계열 계산
-	response, err := s.timeSeriesCalculator.CalculateNodeTimeSeries(nodeName, metrics, windowSpec)
+	response, err := s.timeSeriesCalculator.CalculateNodeTimeSeries(nodeName, metrics, diskMetrics, windowSpec)
 	if err != nil {
 		slog.Error("failed to calculate node time series", "nodeName", nodeName, "error", err)
 		return nil, err
@@ -142,10 +277,14 @@ func (s *nodeService) FindTimeSeriesByNodeName(nodeName, window string) (*dto.No
 }
 
 // calculateCpuMillicores 는 두 개의 NodeMetrics 객체를 비교하여 CPU 사용량을 밀리코어 단위로 계산합니다.
+// 노드가 재부팅된 경우 부팅 이후의 누적 시간으로 계산하며, CPU 를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
 func calculateNodeCpuMillicores(latest, previous *entity.NodeMetrics) float64 {
-	if latest == nil || previous == nil {
+	if latest == nil || previous == nil || !latest.CPUValid || !previous.CPUValid {
 		return 0.0
 	}
+	if newCounterReset(latest, previous).rebooted {
+		previous = &entity.NodeMetrics{}
+	}
 
 	deltaCpuBusy := latest.CPUBusy - previous.CPUBusy
 	deltaCpuTotal := latest.CPUTotal - previous.CPUTotal
@@ -156,3 +295,285 @@ func calculateNodeCpuMillicores(latest, previous *entity.NodeMetrics) float64 {
 
 	return (deltaCpuBusy / deltaCpuTotal) * 1000 * float64(latest.CPUCount)
 }
+
+// buildNodeSystemResponse 는 노드 메트릭에서 부팅 정보, 부하 평균과 프로세스 통계 응답을 생성합니다.
+// 시스템 정보가 저장되지 않은 메트릭이면 nil 을 반환합니다.
+func buildNodeSystemResponse(latest, previous *entity.NodeMetrics) *dto.NodeSystemResponse {
+	if !latest.BootID.Valid {
+		return nil
+	}
+
+	reset := newCounterReset(latest, previous)
+	response := &dto.NodeSystemResponse{
+		BootID:        latest.BootID.String,
+		UptimeSeconds: latest.UptimeSeconds.Int64,
+		Rebooted:      reset.rebooted,
+		Load1:         latest.Load1.Float64,
+		Load5:         latest.Load5.Float64,
+		Load15:        latest.Load15.Float64,
+		ProcsTotal:    latest.ProcsTotal.Int64,
+		ProcsRunning:  latest.ProcsRunning.Int64,
+		ProcsBlocked:  latest.ProcsBlocked.Int64,
+	}
+	if latest.BootTime.Valid {
+		response.BootTime = &latest.BootTime.Time
+	}
+	if previous.ContextSwitches.Valid {
+		interval := latest.Timestamp.Sub(previous.Timestamp)
+		response.ContextSwitchesRate = reset.rate(latest.ContextSwitches.Int64, previous.ContextSwitches.Int64, interval)
+	}
+	return response
+}
+
+// newNodeMemoryResponse 는 노드 메트릭에서 메모리 세부 항목 응답을 생성합니다.
+func newNodeMemoryResponse(metric *entity.NodeMetrics) *dto.NodeMemoryResponse {
+	return &dto.NodeMemoryResponse{
+		TotalBytes:             metric.MemoryTotal,
+		AvailableBytes:         metric.MemoryAvailable,
+		UsedBytes:              metric.MemoryUsed,
+		BuffersBytes:           metric.MemoryBuffers,
+		CachedBytes:            metric.MemoryCached,
+		DirtyBytes:             metric.MemoryDirty,
+		SlabReclaimableBytes:   metric.MemorySlabReclaimable,
+		SlabUnreclaimableBytes: metric.MemorySlabUnreclaimable,
+		SwapTotalBytes:         metric.SwapTotal,
+		SwapUsedBytes:          metric.SwapUsed,
+		SwapUsedPercent:        calculatePercent(metric.SwapUsed, metric.SwapTotal),
+		HugePagesTotal:         metric.HugePagesTotal,
+		HugePagesFree:          metric.HugePagesFree,
+		HugePageSizeBytes:      metric.HugePageSize,
+		HugePagesTotalBytes:    metric.HugePagesTotal * metric.HugePageSize,
+	}
+}
+
+// buildNodeCpuModesResponse 는 두 개의 NodeMetrics 객체를 비교하여 CPU 모드별 시간의 백분율을 계산합니다.
+// 모드별 시간이 저장되기 전이거나 CPU 를 수집하지 못한 메트릭이면 nil 을 반환합니다.
+// 노드가 재부팅된 경우 부팅 이후의 누적 시간으로 계산합니다.
+func buildNodeCpuModesResponse(latest, previous *entity.NodeMetrics) *dto.NodeCpuModesResponse {
+	if !latest.CPUValid || !previous.CPUValid || nodeCpuModesTotal(previous) <= 0 {
+		return nil
+	}
+	if newCounterReset(latest, previous).rebooted {
+		previous = &entity.NodeMetrics{}
+	}
+
+	deltaTotal := nodeCpuModesTotal(latest) - nodeCpuModesTotal(previous)
+	if deltaTotal <= 0 {
+		return nil
+	}
+
+	percent := func(latest, previous float64) float64 {
+		return cpuTimePercent(latest-previous, deltaTotal)
+	}
+	return &dto.NodeCpuModesResponse{
+		UserPercent:      percent(latest.CPUUserSeconds, previous.CPUUserSeconds),
+		NicePercent:      percent(latest.CPUNiceSeconds, previous.CPUNiceSeconds),
+		SystemPercent:    percent(latest.CPUSystemSeconds, previous.CPUSystemSeconds),
+		IdlePercent:      percent(latest.CPUIdleSeconds, previous.CPUIdleSeconds),
+		IowaitPercent:    percent(latest.CPUIowaitSeconds, previous.CPUIowaitSeconds),
+		IrqPercent:       percent(latest.CPUIrqSeconds, previous.CPUIrqSeconds),
+		SoftirqPercent:   percent(latest.CPUSoftirqSeconds, previous.CPUSoftirqSeconds),
+		StealPercent:     percent(latest.CPUStealSeconds, previous.CPUStealSeconds),
+		GuestPercent:     percent(latest.CPUGuestSeconds, previous.CPUGuestSeconds),
+		GuestNicePercent: percent(latest.CPUGuestNiceSeconds, previous.CPUGuestNiceSeconds),
+	}
+}
+
+// nodeCpuModesTotal 은 guest 시간을 제외한 모든 CPU 모드 시간의 합입니다.
+func nodeCpuModesTotal(metric *entity.NodeMetrics) float64 {
+	return metric.CPUUserSeconds + metric.CPUNiceSeconds + metric.CPUSystemSeconds + metric.CPUIdleSeconds +
+		metric.CPUIowaitSeconds + metric.CPUIrqSeconds + metric.CPUSoftirqSeconds + metric.CPUStealSeconds
+}
+
+// buildNodeCpuCoreResponses 는 논리 CPU 별로 가장 최근의 2개의 CPU 메트릭을 비교하여 사용률 응답을 생성합니다.
+// metrics 는 논리 CPU, 시간 역순으로 정렬되어 있어야 하며, 이전 메트릭이 없는 논리 CPU 는 제외합니다.
+func buildNodeCpuCoreResponses(metrics []*entity.NodeCpuMetrics, reset counterReset) []*dto.NodeCpuCoreResponse {
+	responses := []*dto.NodeCpuCoreResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+		if i+1 >= len(metrics) || metrics[i+1].CPU != latest.CPU {
+			continue
+		}
+		previous := metrics[i+1]
+		i++
+		if reset.rebooted {
+			previous = &entity.NodeCpuMetrics{}
+		}
+
+		deltaTotal := nodeCpuCoreTotal(latest) - nodeCpuCoreTotal(previous)
+		if deltaTotal <= 0 {
+			continue
+		}
+		deltaIdle := (latest.IdleSeconds + latest.IowaitSeconds + latest.StealSeconds) -
+			(previous.IdleSeconds + previous.IowaitSeconds + previous.StealSeconds)
+
+		responses = append(responses, &dto.NodeCpuCoreResponse{
+			CPU:                latest.CPU,
+			UtilizationPercent: cpuTimePercent(deltaTotal-deltaIdle, deltaTotal),
+			UserPercent:        cpuTimePercent(latest.UserSeconds-previous.UserSeconds, deltaTotal),
+			SystemPercent:      cpuTimePercent(latest.SystemSeconds-previous.SystemSeconds, deltaTotal),
+			IowaitPercent:      cpuTimePercent(latest.IowaitSeconds-previous.IowaitSeconds, deltaTotal),
+			StealPercent:       cpuTimePercent(latest.StealSeconds-previous.StealSeconds, deltaTotal),
+		})
+	}
+
+	return responses
+}
+
+// nodeCpuCoreTotal 은 guest 시간을 제외한 논리 CPU 의 모든 모드 시간의 합입니다.
+func nodeCpuCoreTotal(metric *entity.NodeCpuMetrics) float64 {
+	return metric.UserSeconds + metric.NiceSeconds + metric.SystemSeconds + metric.IdleSeconds +
+		metric.IowaitSeconds + metric.IrqSeconds + metric.SoftirqSeconds + metric.StealSeconds
+}
+
+// cpuTimePercent 는 전체 CPU 시간 증가량 대비 모드 시간 증가량의 백분율을 계산합니다.
+// 카운터가 감소한 경우(재부팅 등) 0 을 반환합니다.
+func cpuTimePercent(delta, deltaTotal float64) float64 {
+	if deltaTotal <= 0 || delta < 0 {
+		return 0.0
+	}
+	return delta / deltaTotal * 100
+}
+
+// buildNodeDiskResponses 는 장치별로 가장 최근의 2개의 디스크 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 장치명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildNodeDiskResponses(metrics []*entity.NodeDiskMetrics, reset counterReset) []*dto.NodeDiskMetricsResponse {
+	responses := []*dto.NodeDiskMetricsResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.NodeDiskMetricsResponse{
+			Device:         latest.Device,
+			DiskReadBytes:  latest.ReadBytes,
+			DiskWriteBytes: latest.WriteBytes,
+			DiskReadCount:  latest.ReadCount,
+			DiskWriteCount: latest.WriteCount,
+			DiskIoTimeMs:   latest.IoTime,
+		}
+
+		// 같은 장치의 이전 메트릭이 있으면 속도, IOPS, 지연 시간, 사용률을 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].Device == latest.Device {
+			delta := newDiskIoDelta(latest, metrics[i+1], reset)
+			response.DiskReadRate = delta.perSecond(delta.readBytes)
+			response.DiskWriteRate = delta.perSecond(delta.writeBytes)
+			response.DiskReadIops = delta.perSecond(delta.reads)
+			response.DiskWriteIops = delta.perSecond(delta.writes)
+			response.DiskReadMergedRate = delta.perSecond(delta.mergedReads)
+			response.DiskWriteMergedRate = delta.perSecond(delta.mergedWrites)
+			response.DiskReadLatencyMs = delta.readLatencyMs()
+			response.DiskWriteLatencyMs = delta.writeLatencyMs()
+			response.DiskUtilPercent = delta.utilPercent()
+			response.DiskQueueDepth = delta.queueDepth()
+			i++
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// buildNodeInterfaceResponses 는 인터페이스별로 가장 최근의 2개의 네트워크 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 인터페이스명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildNodeInterfaceResponses(metrics []*entity.NodeInterfaceMetrics, reset counterReset) []*dto.NodeInterfaceMetricsResponse {
+	responses := []*dto.NodeInterfaceMetricsResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.NodeInterfaceMetricsResponse{
+			InterfaceName:    latest.InterfaceName,
+			NetworkRxBytes:   latest.RxBytes,
+			NetworkTxBytes:   latest.TxBytes,
+			NetworkRxPackets: latest.RxPackets,
+			NetworkTxPackets: latest.TxPackets,
+			NetworkRxErrors:  latest.RxErrors,
+			NetworkTxErrors:  latest.TxErrors,
+			NetworkRxDropped: latest.RxDropped,
+			NetworkTxDropped: latest.TxDropped,
+		}
+
+		// 같은 인터페이스의 이전 메트릭이 있으면 속도를 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].InterfaceName == latest.InterfaceName {
+			previous := metrics[i+1]
+			interval := latest.Timestamp.Sub(previous.Timestamp)
+			response.NetworkRxRate = reset.rate(latest.RxBytes, previous.RxBytes, interval)
+			response.NetworkTxRate = reset.rate(latest.TxBytes, previous.TxBytes, interval)
+			response.NetworkRxPktsRate = reset.rate(latest.RxPackets, previous.RxPackets, interval)
+			response.NetworkTxPktsRate = reset.rate(latest.TxPackets, previous.TxPackets, interval)
+			i++
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// buildNodeFilesystemResponses 는 마운트 경로별로 가장 최근과 가장 오래된 파일시스템 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 마운트 경로, 시간 역순으로 정렬되어 있어야 합니다.
+// 하나라도 곧 가득 찰 것으로 예상되는 파일시스템이 있으면 true 를 함께 반환합니다.
+func buildNodeFilesystemResponses(metrics []*entity.NodeFilesystemMetrics) ([]*dto.NodeFilesystemMetricsResponse, bool) {
+	responses := []*dto.NodeFilesystemMetricsResponse{}
+	diskFullSoon := false
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.NodeFilesystemMetricsResponse{
+			Mountpoint:        latest.Mountpoint,
+			Device:            latest.Device,
+			FsType:            latest.FsType,
+			SizeBytes:         latest.SizeBytes,
+			UsedBytes:         latest.UsedBytes,
+			AvailBytes:        latest.AvailBytes,
+			UsedPercent:       calculatePercent(latest.UsedBytes, latest.UsedBytes+latest.AvailBytes),
+			InodesTotal:       latest.InodesTotal,
+			InodesUsed:        latest.InodesUsed,
+			InodesUsedPercent: calculatePercent(latest.InodesUsed, latest.InodesTotal),
+		}
+
+		// 같은 마운트 경로의 이전 메트릭이 있으면 증가 속도와 가득 차기까지 남은 시간을 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].Mountpoint == latest.Mountpoint {
+			earliest := metrics[i+1]
+			interval := latest.Timestamp.Sub(earliest.Timestamp)
+			response.GrowthRate = calculateRate(latest.UsedBytes, earliest.UsedBytes, interval)
+			if response.GrowthRate > 0 {
+				hoursUntilFull := float64(latest.AvailBytes) / response.GrowthRate / 3600
+				response.HoursUntilFull = &hoursUntilFull
+			}
+			i++
+		}
+
+		response.DiskFullSoon = response.UsedPercent >= filesystemFullPercent ||
+			response.InodesUsedPercent >= filesystemFullPercent ||
+			(response.HoursUntilFull != nil && *response.HoursUntilFull <= filesystemFullHorizon.Hours())
+		if response.DiskFullSoon {
+			diskFullSoon = true
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, diskFullSoon
+}
+
+// calculatePercent 는 전체 대비 사용량의 백분율을 계산합니다.
+func calculatePercent(used, total int64) float64 {
+	if total <= 0 {
+		return 0.0
+	}
+	return float64(used) / float64(total) * 100
+}
+
+// calculateRate 는 누적 카운터의 두 값과 시간 간격으로 초당 증가량을 계산합니다.
+func calculateRate(latest, previous int64, interval time.Duration) float64 {
+	seconds := interval.Seconds()
+	if seconds <= 0 {
+		return 0.0
+	}
+
+	rate := float64(latest-previous) / seconds
+	if rate < 0 {
+		return 0.0
+	}
+	return rate
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"log/slog"
+	"sort"
 	"time"
 
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
@@ -11,10 +12,11 @@ import (
 )
 
 type PodService interface {
-	FindAll() ([]*dto.PodMetricsResponse, error)
-	FindByPodName(podName string) (*dto.PodMetricsResponse, error)
-	FindByNodeName(nodeName string) ([]*dto.PodMetricsResponse, error)
-	FindTimeSeriesByPodName(podName, window string) (*dto.PodTimeSeriesResponse, error)
+	FindAll(memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error)
+	FindByPodName(podName string, memory utils.MemoryFlavor, breakdown *utils.BreakdownSpec) (*dto.PodMetricsResponse, error)
+	FindByNodeName(nodeName string, memory utils.MemoryFlavor, qos utils.QoSClass) ([]*dto.PodMetricsResponse, error)
+	FindTimeSeriesByPodName(podName, window string, memory utils.MemoryFlavor) (*dto.PodTimeSeriesResponse, error)
+	FindThrottled(threshold float64, window string) ([]*dto.ThrottledPodResponse, error)
 }
 
 type podService struct {
@@ -30,7 +32,7 @@ func NewPodService(podRepository repository.PodRepository) PodService {
 }
 
 // FindAll 는 모든 파드들에 대해 최신 메트릭을 제공합니다.
-func (s *podService) FindAll() ([]*dto.PodMetricsResponse, error) {
+func (s *podService) FindAll(memory utils.MemoryFlavor) ([]*dto.PodMetricsResponse, error) {
 	// 모든 파드들에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	metrics, err := s.podRepository.FindAll()
 	if err != nil {
@@ -57,36 +59,15 @@ func (s *podService) FindAll() ([]*dto.PodMetricsResponse, error) {
 		latest := podMetrics[0]
 		previous := podMetrics[1]
 
-		cpuMillicores := calculatePodCpuMillicores(latest, previous)
-
-		var deploymentName *string
-		if latest.DeploymentName.Valid {
-			deploymentName = &latest.DeploymentName.String
-		}
-
-		response := &dto.PodMetricsResponse{
-			Timestamp:      latest.Timestamp,
-			PodName:        latest.PodName,
-			DeploymentName: deploymentName,
-			NamespaceName:  latest.NamespaceName,
-			NodeName:       latest.NodeName,
-			UID:            latest.UID,
-			CpuMillicores:  cpuMillicores,
-			MemoryBytes:    latest.MemoryUsage,
-			DiskReadBytes:  latest.DiskReadBytes,
-			DiskWriteBytes: latest.DiskWriteBytes,
-			NetworkRxBytes: latest.NetworkRxBytes,
-			NetworkTxBytes: latest.NetworkTxBytes,
-		}
-
-		responses = append(responses, response)
+		responses = append(responses, newPodMetricsResponse(latest, previous, memory))
 	}
 
 	return responses, nil
 }
 
 // FindByPodName 는 주어진 파드명에 대해 최신 메트릭을 제공합니다.
-func (s *podService) FindByPodName(podName string) (*dto.PodMetricsResponse, error) {
+// breakdown 에 device, interface 가 포함되면 장치별 디스크 I/O, 인터페이스별 네트워크 메트릭을 함께 제공합니다.
+func (s *podService) FindByPodName(podName string, memory utils.MemoryFlavor, breakdown *utils.BreakdownSpec) (*dto.PodMetricsResponse, error) {
 	metrics, err := s.podRepository.FindByPodName(podName)
 	if err != nil {
 		slog.Error("failed to get pod metrics by pod name", "pod", podName, "error", err)
@@ -98,33 +79,32 @@ func (s *podService) FindByPodName(podName string) (*dto.PodMetricsResponse, err
 	latest := metrics[0]
 	previous := metrics[1]
 
-	cpuMillicores := calculatePodCpuMillicores(latest, previous)
+	response := newPodMetricsResponse(latest, previous, memory)
 
-	var deploymentName *string
-	if latest.DeploymentName.Valid {
-		deploymentName = &latest.DeploymentName.String
+	if breakdown.Device {
+		diskMetrics, err := s.podRepository.FindDisksByPodUID(latest.UID)
+		if err != nil {
+			slog.Error("failed to get pod disk metrics by pod uid", "pod", podName, "uid", latest.UID, "error", err)
+			return nil, err
+		}
+		response.Disks = buildPodDiskResponses(diskMetrics)
 	}
 
-	response := &dto.PodMetricsResponse{
-		Timestamp:      latest.Timestamp,
-		PodName:        latest.PodName,
-		DeploymentName: deploymentName,
-		NamespaceName:  latest.NamespaceName,
-		NodeName:       latest.NodeName,
-		UID:            latest.UID,
-		CpuMillicores:  cpuMillicores,
-		MemoryBytes:    latest.MemoryUsage,
-		DiskReadBytes:  latest.DiskReadBytes,
-		DiskWriteBytes: latest.DiskWriteBytes,
-		NetworkRxBytes: latest.NetworkRxBytes,
-		NetworkTxBytes: latest.NetworkTxBytes,
+	if breakdown.Interface {
+		interfaceMetrics, err := s.podRepository.FindInterfacesByPodUID(latest.UID)
+		if err != nil {
+			slog.Error("failed to get pod interface metrics by pod uid", "pod", podName, "uid", latest.UID, "error", err)
+			return nil, err
+		}
+		response.Interfaces = buildPodInterfaceResponses(interfaceMetrics)
 	}
 
 	return response, nil
 }
 
 // FindByNodeName 는 주어진 노드명에 대해 모든 파드의 최신 메트릭을 제공합니다.
-func (s *podService) FindByNodeName(nodeName string) ([]*dto.PodMetricsResponse, error) {
+// qos 가 지정되면 해당 QoS 등급의 파드만 제공하며, 일치하는 파드가 없으면 빈 목록을 반환합니다.
+func (s *podService) FindByNodeName(nodeName string, memory utils.MemoryFlavor, qos utils.QoSClass) ([]*dto.PodMetricsResponse, error) {
 	// 주어진 노드명을 가지는 모든 파드에 대해 가장 최근의 2개의 메트릭을 조회합니다.
 	metrics, err := s.podRepository.FindByNodeName(nodeName)
 	if err != nil {
@@ -142,7 +122,7 @@ func (s *podService) FindByNodeName(nodeName string) ([]*dto.PodMetricsResponse,
 	}
 
 	// 각 파드에 대해 가장 최근의 2개의 메트릭을 비교하여 응답을 생성합니다.
-	var responses []*dto.PodMetricsResponse
+	responses := []*dto.PodMetricsResponse{}
 	for _, podMetrics := range metricsMap {
 		if len(podMetrics) < 2 {
 			continue // 최소 2개의 메트릭이 있어야 비교 가능
@@ -151,37 +131,62 @@ func (s *podService) FindByNodeName(nodeName string) ([]*dto.PodMetricsResponse,
 		latest := podMetrics[0]
 		previous := podMetrics[1]
 
-		cpuMillicores := calculatePodCpuMillicores(latest, previous)
-
-		var deploymentName *string
-		if latest.DeploymentName.Valid {
-			deploymentName = &latest.DeploymentName.String
+		if qos != utils.QoSAll && latest.QoSClass.String != string(qos) {
+			continue
 		}
 
-		response := &dto.PodMetricsResponse{
-			Timestamp:      latest.Timestamp,
-			PodName:        latest.PodName,
-			DeploymentName: deploymentName,
-			NamespaceName:  latest.NamespaceName,
-			NodeName:       latest.NodeName,
-			UID:            latest.UID,
-			CpuMillicores:  cpuMillicores,
-			MemoryBytes:    latest.MemoryUsage,
-			DiskReadBytes:  latest.DiskReadBytes,
-			DiskWriteBytes: latest.DiskWriteBytes,
-			NetworkRxBytes: latest.NetworkRxBytes,
-			NetworkTxBytes: latest.NetworkTxBytes,
-		}
-
-		responses = append(responses, response)
+		responses = append(responses, newPodMetricsResponse(latest, previous, memory))
 	}
 
 	return responses, nil
 }
 
+// newPodMetricsResponse 는 파드의 가장 최근의 2개의 메트릭을 비교하여 파드 메트릭 응답을 생성합니다.
+func newPodMetricsResponse(latest, previous *entity.PodMetrics, memory utils.MemoryFlavor) *dto.PodMetricsResponse {
+	cpuMillicores := calculatePodCpuMillicores(latest, previous)
+
+	var deploymentName *string
+	if latest.DeploymentName.Valid {
+		deploymentName = &latest.DeploymentName.String
+	}
+
+	return &dto.PodMetricsResponse{
+		Timestamp:        latest.Timestamp,
+		PodName:          latest.PodName,
+		DeploymentName:   deploymentName,
+		NamespaceName:    latest.NamespaceName,
+		NodeName:         latest.NodeName,
+		UID:              latest.UID,
+		QoSClass:         newPodQoSClass(latest),
+		CpuMillicores:    cpuMillicores,
+		CpuThrottleRatio: calculatePodThrottleRatio(latest, previous),
+		MemoryBytes:      selectPodMemoryBytes(latest, memory),
+		Memory:           newPodMemoryResponse(latest),
+		OomKills:         latest.OomKillEvents,
+		Limits:           newPodLimitsResponse(latest, cpuMillicores),
+		EphemeralStorage: newPodEphemeralStorageResponse(latest),
+		Processes:        newPodProcessResponse(latest),
+		TCP:              newPodTCPResponse(latest, previous),
+		DiskReadBytes:    latest.DiskReadBytes,
+		DiskWriteBytes:   latest.DiskWriteBytes,
+		DiskReadIops:     calculatePodDiskIops(latest.DiskReadCount, previous.DiskReadCount, latest, previous),
+		DiskWriteIops:    calculatePodDiskIops(latest.DiskWriteCount, previous.DiskWriteCount, latest, previous),
+		NetworkRxBytes:   latest.NetworkRxBytes,
+		NetworkTxBytes:   latest.NetworkTxBytes,
+		HostNetwork:      latest.HostNetwork,
+	}
+}
+
+// includeInNetworkTotals 는 파드의 네트워크 값을 네임스페이스, 디플로이먼트 합계에 포함할지 확인합니다.
+// hostNetwork 파드의 네트워크 값은 노드 전체의 값이므로 합산하지 않습니다.
+func includeInNetworkTotals(metric *entity.PodMetrics) bool {
+	return !metric.HostNetwork
+}
+
 // calculateCpuMillicores 는 이전 메트릭과 최신 메트릭을 비교하여 CPU 밀리코어를 계산합니다.
+// 두 메트릭 중 CPU 를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
 func calculatePodCpuMillicores(latest, previous *entity.PodMetrics) float64 {
-	if latest == nil || previous == nil {
+	if latest == nil || previous == nil || !latest.CPUValid || !previous.CPUValid {
 		return 0.0
 	}
 
@@ -195,8 +200,140 @@ func calculatePodCpuMillicores(latest, previous *entity.PodMetrics) float64 {
 	return float64(deltaCpuUsage) / (interval * 1e3)
 }
 
+// calculatePodDiskIops 는 이전 메트릭과 최신 메트릭 사이의 파드 디스크 I/O 횟수의 초당 증가량을 계산합니다.
+// 두 메트릭 중 디스크를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
+func calculatePodDiskIops(latestValue, previousValue int64, latest, previous *entity.PodMetrics) float64 {
+	if !latest.DiskValid || !previous.DiskValid {
+		return 0.0
+	}
+	return calculateRate(latestValue, previousValue, latest.Timestamp.Sub(previous.Timestamp))
+}
+
+// buildPodDiskResponses 는 장치별로 가장 최근의 2개의 파드 디스크 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 장치명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildPodDiskResponses(metrics []*entity.PodDiskMetrics) []*dto.PodDiskMetricsResponse {
+	responses := []*dto.PodDiskMetricsResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.PodDiskMetricsResponse{
+			Device:         latest.Device,
+			DiskReadBytes:  latest.ReadBytes,
+			DiskWriteBytes: latest.WriteBytes,
+			DiskReadCount:  latest.ReadCount,
+			DiskWriteCount: latest.WriteCount,
+		}
+
+		// 같은 장치의 이전 메트릭이 있으면 속도와 IOPS 를 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].Device == latest.Device {
+			previous := metrics[i+1]
+			interval := latest.Timestamp.Sub(previous.Timestamp)
+			response.DiskReadRate = calculateRate(latest.ReadBytes, previous.ReadBytes, interval)
+			response.DiskWriteRate = calculateRate(latest.WriteBytes, previous.WriteBytes, interval)
+			response.DiskReadIops = calculateRate(latest.ReadCount, previous.ReadCount, interval)
+			response.DiskWriteIops = calculateRate(latest.WriteCount, previous.WriteCount, interval)
+			i++
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// buildPodInterfaceResponses 는 인터페이스별로 가장 최근의 2개의 파드 네트워크 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 인터페이스명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildPodInterfaceResponses(metrics []*entity.PodInterfaceMetrics) []*dto.PodInterfaceMetricsResponse {
+	responses := []*dto.PodInterfaceMetricsResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.PodInterfaceMetricsResponse{
+			InterfaceName:    latest.InterfaceName,
+			NetworkRxBytes:   latest.RxBytes,
+			NetworkTxBytes:   latest.TxBytes,
+			NetworkRxPackets: latest.RxPackets,
+			NetworkTxPackets: latest.TxPackets,
+			NetworkRxErrors:  latest.RxErrors,
+			NetworkTxErrors:  latest.TxErrors,
+			NetworkRxDropped: latest.RxDropped,
+			NetworkTxDropped: latest.TxDropped,
+		}
+
+		// 같은 인터페이스의 이전 메트릭이 있으면 속도를 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].InterfaceName == latest.InterfaceName {
+			previous := metrics[i+1]
+			interval := latest.Timestamp.Sub(previous.Timestamp)
+			response.NetworkRxRate = calculateRate(latest.RxBytes, previous.RxBytes, interval)
+			response.NetworkTxRate = calculateRate(latest.TxBytes, previous.TxBytes, interval)
+			response.NetworkRxPktsRate = calculateRate(latest.RxPackets, previous.RxPackets, interval)
+			response.NetworkTxPktsRate = calculateRate(latest.TxPackets, previous.TxPackets, interval)
+			i++
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// calculatePodThrottleDelta 는 이전 메트릭과 최신 메트릭 사이에 경과한 CFS 주기 수와 스로틀링된 주기 수를 계산합니다.
+// 파드 재생성 등으로 카운터가 초기화되었거나 CPU 를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
+func calculatePodThrottleDelta(latest, previous *entity.PodMetrics) (int64, int64) {
+	if latest == nil || previous == nil || !latest.CPUValid || !previous.CPUValid {
+		return 0, 0
+	}
+
+	deltaPeriods := latest.CPUNrPeriods - previous.CPUNrPeriods
+	deltaThrottled := latest.CPUNrThrottled - previous.CPUNrThrottled
+	if deltaPeriods <= 0 || deltaThrottled < 0 {
+		return 0, 0
+	}
+
+	return deltaPeriods, deltaThrottled
+}
+
+// calculatePodThrottleRatio 는 이전 메트릭과 최신 메트릭을 비교하여 CPU 스로틀링 비율을 계산합니다.
+func calculatePodThrottleRatio(latest, previous *entity.PodMetrics) float64 {
+	return calculateThrottleRatio(calculatePodThrottleDelta(latest, previous))
+}
+
+// calculateThrottleRatio 는 CFS 주기 중 스로틀링된 주기의 비율을 계산합니다.
+func calculateThrottleRatio(periods, throttled int64) float64 {
+	if periods <= 0 {
+		return 0.0
+	}
+
+	return float64(throttled) / float64(periods)
+}
+
+// selectPodMemoryBytes 는 요청된 메모리 기준에 해당하는 파드 메모리 사용량을 반환합니다.
+func selectPodMemoryBytes(metric *entity.PodMetrics, memory utils.MemoryFlavor) int64 {
+	switch memory {
+	case utils.MemoryWorkingSet:
+		return metric.MemoryWorkingSet
+	case utils.MemoryRSS:
+		return metric.MemoryRSS
+	default:
+		return metric.MemoryUsage
+	}
+}
+
+// newPodMemoryResponse 는 파드 메트릭으로부터 memory.stat 세부 항목 응답을 생성합니다.
+func newPodMemoryResponse(metric *entity.PodMetrics) *dto.PodMemoryResponse {
+	return &dto.PodMemoryResponse{
+		UsageBytes:      metric.MemoryUsage,
+		WorkingSetBytes: metric.MemoryWorkingSet,
+		RSSBytes:        metric.MemoryRSS,
+		CacheBytes:      metric.MemoryCache,
+		KernelBytes:     metric.MemoryKernel,
+		SockBytes:       metric.MemorySock,
+		SwapBytes:       metric.MemorySwap,
+	}
+}
+
 // FindTimeSeriesByPodName 는 주어진 파드명과 윈도우에 대해 시계열 메트릭을 제공합니다.
-func (s *podService) FindTimeSeriesByPodName(podName, window string) (*dto.PodTimeSeriesResponse, error) {
+func (s *podService) FindTimeSeriesByPodName(podName, window string, memory utils.MemoryFlavor) (*dto.PodTimeSeriesResponse, error) {
 	// 윈도우 파라미터 파싱
 	windowSpec, err := utils.ParseWindow(window)
 	if err != nil {
@@ -220,7 +357,7 @@ func (s *podService) FindTimeSeriesByPodName(podName, window string) (*dto.PodTi
 	}
 
 	// 시계열 계산
-	response, err := s.timeSeriesCalculator.CalculatePodTimeSeries(podName, metrics, windowSpec)
+	response, err := s.timeSeriesCalculator.CalculatePodTimeSeries(podName, metrics, windowSpec, memory)
 	if err != nil {
 		slog.Error("failed to calculate pod time series", "podName", podName, "error", err)
 		return nil, err
@@ -228,3 +365,196 @@ func (s *podService) FindTimeSeriesByPodName(podName, window string) (*dto.PodTi
 
 	return response, nil
 }
+
+// newPodEphemeralStorageResponse 는 파드 메트릭으로부터 임시 스토리지 사용량 응답을 생성합니다.
+// 수집기가 임시 스토리지 사용량을 수집하지 못한 경우 nil 을 반환합니다.
+func newPodEphemeralStorageResponse(metric *entity.PodMetrics) *dto.PodEphemeralStorageResponse {
+	if !metric.EphemeralEmptyDirBytes.Valid || !metric.EphemeralWritableLayerBytes.Valid {
+		return nil
+	}
+
+	return &dto.PodEphemeralStorageResponse{
+		EmptyDirBytes:      metric.EphemeralEmptyDirBytes.Int64,
+		WritableLayerBytes: metric.EphemeralWritableLayerBytes.Int64,
+		TotalBytes:         metric.EphemeralEmptyDirBytes.Int64 + metric.EphemeralWritableLayerBytes.Int64,
+	}
+}
+
+// newPodProcessResponse 는 파드 메트릭에서 프로세스 수와 파일 디스크립터 수 응답을 생성합니다.
+// 모두 수집하지 못했으면 nil 을 반환합니다.
+func newPodProcessResponse(metric *entity.PodMetrics) *dto.PodProcessResponse {
+	if !metric.PidsCurrent.Valid && !metric.OpenFds.Valid {
+		return nil
+	}
+
+	response := &dto.PodProcessResponse{}
+	if metric.PidsCurrent.Valid {
+		response.Pids = &metric.PidsCurrent.Int64
+	}
+	if metric.PidsMax.Valid && metric.PidsMax.Int64 > 0 {
+		response.PidsMax = &metric.PidsMax.Int64
+		if metric.PidsCurrent.Valid {
+			ratio := float64(metric.PidsCurrent.Int64) / float64(metric.PidsMax.Int64)
+			response.PidsRatio = &ratio
+		}
+	}
+	if metric.OpenFds.Valid {
+		response.OpenFds = &metric.OpenFds.Int64
+	}
+	return response
+}
+
+// newPodQoSClass 는 파드의 QoS 등급을 반환합니다.
+// QoS 등급을 보내지 않는 이전 버전 수집기의 메트릭이면 nil 을 반환합니다.
+func newPodQoSClass(metric *entity.PodMetrics) *string {
+	if !metric.QoSClass.Valid {
+		return nil
+	}
+	return &metric.QoSClass.String
+}
+
+// newPodLimitsResponse 는 파드 메트릭에서 CPU, 메모리 제한과 제한 대비 사용률 응답을 생성합니다.
+// 수집기가 제한을 수집하지 못한 경우 nil 을 반환합니다.
+func newPodLimitsResponse(metric *entity.PodMetrics, cpuMillicores float64) *dto.PodLimitsResponse {
+	if !metric.CPUWeight.Valid {
+		return nil
+	}
+
+	response := &dto.PodLimitsResponse{
+		CpuWeight:            metric.CPUWeight.Int64,
+		CpuRequestMillicores: cpuWeightToMillicores(metric.CPUWeight.Int64),
+	}
+	if metric.CPUQuotaUsec.Int64 > 0 && metric.CPUPeriodUsec.Int64 > 0 {
+		limit := float64(metric.CPUQuotaUsec.Int64) / float64(metric.CPUPeriodUsec.Int64) * 1000
+		percent := cpuMillicores / limit * 100
+		response.CpuLimitMillicores = &limit
+		response.CpuLimitPercent = &percent
+	}
+	if metric.MemoryMax.Int64 > 0 {
+		percent := float64(metric.MemoryWorkingSet) / float64(metric.MemoryMax.Int64) * 100
+		response.MemoryLimitBytes = &metric.MemoryMax.Int64
+		response.MemoryLimitPercent = &percent
+	}
+	if metric.MemoryHigh.Int64 > 0 {
+		response.MemoryHighBytes = &metric.MemoryHigh.Int64
+	}
+	return response
+}
+
+// cpuWeightToMillicores 는 cpu.weight 를 kubelet 이 설정한 cpu.shares 로 되돌려 CPU requests 를 밀리코어로 환산합니다.
+// kubelet 은 requests 가 없으면 최소값(shares 2)을 설정하므로 0 을 반환합니다.
+// shares 와 weight 사이의 변환에서 정수 나눗셈으로 오차가 생기므로 근사값입니다.
+func cpuWeightToMillicores(weight int64) float64 {
+	if weight <= 1 {
+		return 0.0
+	}
+	shares := 2 + ((weight-1)*262142)/9999
+	return float64(shares) * 1000 / 1024
+}
+
+// FindThrottled 는 주어진 윈도우 동안 CPU 스로틀링 비율이 임계값을 초과한 파드 목록을 제공합니다.
+// 스로틀링 비율이 높은 순서로 정렬됩니다.
+func (s *podService) FindThrottled(threshold float64, window string) ([]*dto.ThrottledPodResponse, error) {
+	// 윈도우 파라미터 파싱
+	windowSpec, err := utils.ParseWindow(window)
+	if err != nil {
+		slog.Error("failed to parse window parameter", "window", window, "error", err)
+		return nil, err
+	}
+
+	// 시간 범위 계산 (UTC 변환)
+	endTime := time.Now().UTC()
+	startTime := windowSpec.GetStartTime(endTime)
+
+	// 시간 범위 내에서 각 파드의 처음과 마지막 메트릭을 조회합니다.
+	metrics, err := s.podRepository.FindBoundsInTimeWindow(startTime, endTime)
+	if err != nil {
+		slog.Error("failed to get pod metrics in time window", "startTime", startTime, "endTime", endTime, "error", err)
+		return nil, err
+	}
+
+	// 파드 UID별로 메트릭을 그룹화합니다.
+	metricsMap := make(map[string][]*entity.PodMetrics)
+	for _, metric := range metrics {
+		metricsMap[metric.UID] = append(metricsMap[metric.UID], metric)
+	}
+
+	responses := []*dto.ThrottledPodResponse{}
+	for _, podMetrics := range metricsMap {
+		if len(podMetrics) < 2 {
+			continue // 최소 2개의 메트릭이 있어야 비교 가능
+		}
+
+		latest := podMetrics[0]
+		earliest := podMetrics[1]
+
+		periods, throttled := calculatePodThrottleDelta(latest, earliest)
+		ratio := calculateThrottleRatio(periods, throttled)
+		if ratio <= threshold {
+			continue
+		}
+
+		var deploymentName *string
+		if latest.DeploymentName.Valid {
+			deploymentName = &latest.DeploymentName.String
+		}
+
+		responses = append(responses, &dto.ThrottledPodResponse{
+			PodName:          latest.PodName,
+			DeploymentName:   deploymentName,
+			NamespaceName:    latest.NamespaceName,
+			NodeName:         latest.NodeName,
+			UID:              latest.UID,
+			Window:           windowSpec.String(),
+			StartTime:        earliest.Timestamp,
+			EndTime:          latest.Timestamp,
+			CpuThrottleRatio: ratio,
+			CpuPeriods:       periods,
+			CpuThrottled:     throttled,
+			CpuThrottledSecs: float64(latest.CPUThrottledUsec-earliest.CPUThrottledUsec) / 1e6,
+		})
+	}
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].CpuThrottleRatio > responses[j].CpuThrottleRatio
+	})
+
+	return responses, nil
+}
+
+// newPodTCPResponse 는 파드 메트릭에서 TCP 연결 통계 응답을 생성합니다.
+// 최신 메트릭의 TCP 통계를 수집하지 못했으면 nil 을, 이전 메트릭의 TCP 통계가 없으면 속도 없이 개수만 반환합니다.
+func newPodTCPResponse(latest, previous *entity.PodMetrics) *dto.PodTCPResponse {
+	if !latest.TCPEstablished.Valid {
+		return nil
+	}
+
+	response := &dto.PodTCPResponse{
+		Established:     latest.TCPEstablished.Int64,
+		SynSent:         latest.TCPSynSent.Int64,
+		SynRecv:         latest.TCPSynRecv.Int64,
+		FinWait:         latest.TCPFinWait.Int64,
+		TimeWait:        latest.TCPTimeWait.Int64,
+		CloseWait:       latest.TCPCloseWait.Int64,
+		LastAck:         latest.TCPLastAck.Int64,
+		Listen:          latest.TCPListen.Int64,
+		RetransSegs:     latest.TCPRetransSegs.Int64,
+		ListenOverflows: latest.TCPListenOverflows.Int64,
+		ListenDrops:     latest.TCPListenDrops.Int64,
+	}
+
+	if previous != nil && previous.TCPEstablished.Valid {
+		interval := latest.Timestamp.Sub(previous.Timestamp)
+		response.RetransRate = calculateRate(latest.TCPRetransSegs.Int64, previous.TCPRetransSegs.Int64, interval)
+		response.ListenOverflowRate = calculateRate(latest.TCPListenOverflows.Int64, previous.TCPListenOverflows.Int64, interval)
+		response.ListenDropRate = calculateRate(latest.TCPListenDrops.Int64, previous.TCPListenDrops.Int64, interval)
+
+		deltaOutSegs := latest.TCPOutSegs.Int64 - previous.TCPOutSegs.Int64
+		deltaRetransSegs := latest.TCPRetransSegs.Int64 - previous.TCPRetransSegs.Int64
+		if deltaOutSegs > 0 && deltaRetransSegs >= 0 {
+			response.RetransRatio = float64(deltaRetransSegs) / float64(deltaOutSegs)
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/repository"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/utils"
+)
+
+type PressureService interface {
+	FindByNodeName(nodeName string) (*dto.NodePressureResponse, error)
+	FindTimeSeriesByNodeName(nodeName, window string) (*dto.NodePressureTimeSeriesResponse, error)
+	FindByPodName(podName string) (*dto.PodPressureResponse, error)
+	FindTimeSeriesByPodName(podName, window string) (*dto.PodPressureTimeSeriesResponse, error)
+}
+
+type pressureService struct {
+	pressureRepository repository.PressureRepository
+}
+
+func NewPressureService(pressureRepository repository.PressureRepository) PressureService {
+	return &pressureService{
+		pressureRepository: pressureRepository,
+	}
+}
+
+// FindByNodeName 은 주어진 노드명에 대해 자원별 최신 PSI 메트릭을 제공합니다.
+func (s *pressureService) FindByNodeName(nodeName string) (*dto.NodePressureResponse, error) {
+	metrics, err := s.pressureRepository.FindByNodeName(nodeName)
+	if err != nil {
+		slog.Error("failed to get node pressure metrics by node name", "nodeName", nodeName, "error", err)
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	pressureMetrics := make([]*entity.PressureMetrics, 0, len(metrics))
+	for _, metric := range metrics {
+		pressureMetrics = append(pressureMetrics, &metric.PressureMetrics)
+	}
+
+	return &dto.NodePressureResponse{
+		NodeName:  nodeName,
+		Timestamp: latestPressureTimestamp(pressureMetrics),
+		Pressure:  buildPressureResponses(pressureMetrics),
+	}, nil
+}
+
+// FindTimeSeriesByNodeName 은 주어진 노드명과 윈도우에 대해 자원별 PSI 요약을 제공합니다.
+func (s *pressureService) FindTimeSeriesByNodeName(nodeName, window string) (*dto.NodePressureTimeSeriesResponse, error) {
+	// 윈도우 파라미터 파싱
+	windowSpec, err := utils.ParseWindow(window)
+	if err != nil {
+		slog.Error("failed to parse window parameter", "window", window, "error", err)
+		return nil, err
+	}
+
+	// 시간 범위 계산 (UTC 변환)
+	endTime := time.Now().UTC()
+	startTime := windowSpec.GetStartTime(endTime)
+
+	metrics, err := s.pressureRepository.FindByNodeNameInTimeWindow(nodeName, startTime, endTime)
+	if err != nil {
+		slog.Error("failed to get node pressure metrics in time window", "nodeName", nodeName, "startTime", startTime, "endTime", endTime, "error", err)
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	pressureMetrics := make([]*entity.PressureMetrics, 0, len(metrics))
+	for _, metric := range metrics {
+		pressureMetrics = append(pressureMetrics, &metric.PressureMetrics)
+	}
+
+	endTime = latestPressureTimestamp(pressureMetrics)
+	return &dto.NodePressureTimeSeriesResponse{
+		NodeName:  nodeName,
+		Window:    windowSpec.String(),
+		StartTime: windowSpec.GetStartTime(endTime),
+		EndTime:   endTime,
+		Pressure:  buildPressureTimeSeriesResponses(pressureMetrics),
+	}, nil
+}
+
+// FindByPodName 은 주어진 파드명에 대해 자원별 최신 PSI 메트릭을 제공합니다.
+func (s *pressureService) FindByPodName(podName string) (*dto.PodPressureResponse, error) {
+	metrics, err := s.pressureRepository.FindByPodName(podName)
+	if err != nil {
+		slog.Error("failed to get pod pressure metrics by pod name", "podName", podName, "error", err)
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	pressureMetrics := make([]*entity.PressureMetrics, 0, len(metrics))
+	for _, metric := range metrics {
+		pressureMetrics = append(pressureMetrics, &metric.PressureMetrics)
+	}
+
+	latest := metrics[0]
+	return &dto.PodPressureResponse{
+		PodName:       latest.PodName,
+		NamespaceName: latest.NamespaceName,
+		NodeName:      latest.NodeName,
+		UID:           latest.PodUID,
+		Timestamp:     latestPressureTimestamp(pressureMetrics),
+		Pressure:      buildPressureResponses(pressureMetrics),
+	}, nil
+}
+
+// FindTimeSeriesByPodName 은 주어진 파드명과 윈도우에 대해 자원별 PSI 요약을 제공합니다.
+func (s *pressureService) FindTimeSeriesByPodName(podName, window string) (*dto.PodPressureTimeSeriesResponse, error) {
+	// 윈도우 파라미터 파싱
+	windowSpec, err := utils.ParseWindow(window)
+	if err != nil {
+		slog.Error("failed to parse window parameter", "window", window, "error", err)
+		return nil, err
+	}
+
+	// 시간 범위 계산 (UTC 변환)
+	endTime := time.Now().UTC()
+	startTime := windowSpec.GetStartTime(endTime)
+
+	metrics, err := s.pressureRepository.FindByPodNameInTimeWindow(podName, startTime, endTime)
+	if err != nil {
+		slog.Error("failed to get pod pressure metrics in time window", "podName", podName, "startTime", startTime, "endTime", endTime, "error", err)
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	pressureMetrics := make([]*entity.PressureMetrics, 0, len(metrics))
+	for _, metric := range metrics {
+		pressureMetrics = append(pressureMetrics, &metric.PressureMetrics)
+	}
+
+	latest := metrics[0]
+	endTime = latestPressureTimestamp(pressureMetrics)
+	return &dto.PodPressureTimeSeriesResponse{
+		PodName:       latest.PodName,
+		NamespaceName: latest.NamespaceName,
+		NodeName:      latest.NodeName,
+		UID:           latest.PodUID,
+		Window:        windowSpec.String(),
+		StartTime:     windowSpec.GetStartTime(endTime),
+		EndTime:       endTime,
+		Pressure:      buildPressureTimeSeriesResponses(pressureMetrics),
+	}, nil
+}
+
+// buildPressureResponses 는 자원별로 가장 최근의 2개의 PSI 메트릭을 비교하여 응답을 생성합니다.
+// metrics 는 자원명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildPressureResponses(metrics []*entity.PressureMetrics) []*dto.PressureResponse {
+	responses := []*dto.PressureResponse{}
+	for i := 0; i < len(metrics); i++ {
+		latest := metrics[i]
+
+		response := &dto.PressureResponse{
+			Resource:      latest.Resource,
+			SomeAvg10:     latest.SomeAvg10,
+			SomeAvg60:     latest.SomeAvg60,
+			SomeAvg300:    latest.SomeAvg300,
+			SomeTotalUsec: latest.SomeTotal,
+			FullAvg10:     latest.FullAvg10,
+			FullAvg60:     latest.FullAvg60,
+			FullAvg300:    latest.FullAvg300,
+			FullTotalUsec: latest.FullTotal,
+		}
+
+		// 같은 자원의 이전 메트릭이 있으면 멈춘 시간 비율을 계산합니다.
+		// 노드가 재부팅된 경우 부팅 이후의 누적 시간으로 계산합니다.
+		if i+1 < len(metrics) && metrics[i+1].Resource == latest.Resource {
+			previous := metrics[i+1]
+			reset := newPressureCounterReset(latest, previous)
+			interval := latest.Timestamp.Sub(previous.Timestamp)
+			response.SomeStallPercent = calculateStallPercent(reset.rate(latest.SomeTotal, previous.SomeTotal, interval))
+			response.FullStallPercent = calculateStallPercent(reset.rate(latest.FullTotal, previous.FullTotal, interval))
+			i++
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// buildPressureTimeSeriesResponses 는 자원별로 윈도우 내 PSI 메트릭을 요약합니다.
+// 평균 비율은 연속된 메트릭 사이의 total 증가량을 합산하여 계산하므로, 윈도우 중간에 노드가 재부팅되어도 재부팅 전후의 증가량을 모두 포함합니다.
+// metrics 는 자원명, 시간 역순으로 정렬되어 있어야 합니다.
+func buildPressureTimeSeriesResponses(metrics []*entity.PressureMetrics) []*dto.PressureTimeSeriesResponse {
+	responses := []*dto.PressureTimeSeriesResponse{}
+	for start := 0; start < len(metrics); {
+		// 같은 자원의 메트릭 구간을 찾습니다.
+		end := start
+		for end < len(metrics) && metrics[end].Resource == metrics[start].Resource {
+			end++
+		}
+
+		var someDelta, fullDelta int64
+		var interval time.Duration
+		for i := start; i+1 < end; i++ {
+			latest, previous := metrics[i], metrics[i+1]
+			reset := newPressureCounterReset(latest, previous)
+			interval += reset.interval(latest.Timestamp.Sub(previous.Timestamp))
+			someDelta += reset.delta(latest.SomeTotal, previous.SomeTotal)
+			fullDelta += reset.delta(latest.FullTotal, previous.FullTotal)
+		}
+
+		response := &dto.PressureTimeSeriesResponse{
+			Resource:            metrics[start].Resource,
+			AvgSomeStallPercent: calculateStallPercent(calculateRate(someDelta, 0, interval)),
+			AvgFullStallPercent: calculateStallPercent(calculateRate(fullDelta, 0, interval)),
+		}
+		for _, metric := range metrics[start:end] {
+			response.MaxSomeAvg10 = max(response.MaxSomeAvg10, metric.SomeAvg10)
+			response.MaxFullAvg10 = max(response.MaxFullAvg10, metric.FullAvg10)
+		}
+
+		responses = append(responses, response)
+		start = end
+	}
+
+	return responses
+}
+
+// calculateStallPercent 는 누적 stall 시간(usec)의 초당 증가량을 멈춘 시간의 비율(%)로 변환합니다.
+func calculateStallPercent(usecPerSecond float64) float64 {
+	return usecPerSecond / 1e6 * 100
+}
+
+// latestPressureTimestamp 는 PSI 메트릭 중 가장 최근 수집 시각을 반환합니다.
+func latestPressureTimestamp(metrics []*entity.PressureMetrics) time.Time {
+	var latest time.Time
+	for _, metric := range metrics {
+		if metric.Timestamp.After(latest) {
+			latest = metric.Timestamp
+		}
+	}
+	return latest
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/dto"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/api/entity"
@@ -9,9 +10,9 @@ import (
 )
 
 type TimeSeriesCalculator interface {
-	CalculateNodeTimeSeries(nodeName string, metrics []*entity.NodeMetrics, window *utils.WindowSpec) (*dto.NodeTimeSeriesResponse, error)
-	CalculatePodTimeSeries(podName string, metrics []*entity.PodMetrics, window *utils.WindowSpec) (*dto.PodTimeSeriesResponse, error)
-	CalculateNamespaceTimeSeries(namespaceName string, metrics []*entity.PodMetrics, window *utils.WindowSpec) (*dto.NamespaceTimeSeriesResponse, error)
+	CalculateNodeTimeSeries(nodeName string, metrics []*entity.NodeMetrics, diskMetrics []*entity.NodeDiskMetrics, window *utils.WindowSpec) (*dto.NodeTimeSeriesResponse, error)
+	CalculatePodTimeSeries(podName string, metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (*dto.PodTimeSeriesResponse, error)
+	CalculateNamespaceTimeSeries(namespaceName string, metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (*dto.NamespaceTimeSeriesResponse, error)
 }
 
 type timeSeriesCalculator struct{}
@@ -20,8 +21,8 @@ func NewTimeSeriesCalculator() TimeSeriesCalculator {
 	return &timeSeriesCalculator{}
 }
 
-// CalculateNodeTimeSeries 는 노드 메트릭들로부터 시계열 데이터를 계산합니다.
-func (c *timeSeriesCalculator) CalculateNodeTimeSeries(nodeName string, metrics []*entity.NodeMetrics, window *utils.WindowSpec) (*dto.NodeTimeSeriesResponse, error) {
+// CalculateNodeTimeSeries 는 노드 메트릭들과 장치별 디스크 메트릭들로부터 시계열 데이터를 계산합니다.
+func (c *timeSeriesCalculator) CalculateNodeTimeSeries(nodeName string, metrics []*entity.NodeMetrics, diskMetrics []*entity.NodeDiskMetrics, window *utils.WindowSpec) (*dto.NodeTimeSeriesResponse, error) {
 	if len(metrics) < 2 {
 		return nil, fmt.Errorf("insufficient data points for time series calculation (need at least 2, got %d)", len(metrics))
 	}
@@ -52,11 +53,66 @@ func (c *timeSeriesCalculator) CalculateNodeTimeSeries(nodeName string, metrics
 		AvgDiskWriteRate: avgDiskWriteRate,
 		AvgNetworkRxRate: avgNetworkRxRate,
 		AvgNetworkTxRate: avgNetworkTxRate,
+		Disks:            c.calculateNodeDiskAverages(metrics, diskMetrics, window),
 	}
 
 	return response, nil
 }
 
+// calculateNodeDiskAverages 는 장치별 디스크 메트릭들로부터 구간 동안의 I/O 요약을 계산합니다.
+// diskMetrics 는 장치명, 시간 역순으로 정렬되어 있어야 합니다.
+// 디스크 메트릭은 노드 메트릭과 같은 시각에 저장되므로, 같은 시각의 노드 메트릭으로 재부팅 여부를 판단합니다.
+func (c *timeSeriesCalculator) calculateNodeDiskAverages(metrics []*entity.NodeMetrics, diskMetrics []*entity.NodeDiskMetrics, window *utils.WindowSpec) []*dto.NodeDiskTimeSeriesResponse {
+	nodeMetricsByTime := make(map[int64]*entity.NodeMetrics, len(metrics))
+	for _, metric := range metrics {
+		nodeMetricsByTime[metric.Timestamp.UnixNano()] = metric
+	}
+
+	windowDuration := window.ToDuration()
+	responses := []*dto.NodeDiskTimeSeriesResponse{}
+	for i := 0; i < len(diskMetrics); {
+		device := diskMetrics[i].Device
+
+		// 같은 장치의 각 연속된 메트릭 쌍의 증가량을 더합니다.
+		var total diskIoDelta
+		var count int
+		for ; i+1 < len(diskMetrics) && diskMetrics[i+1].Device == device; i++ {
+			current := diskMetrics[i]
+			next := diskMetrics[i+1]
+
+			// 윈도우 내의 데이터만 사용
+			if current.Timestamp.Sub(next.Timestamp) > windowDuration {
+				continue
+			}
+
+			reset := newCounterReset(nodeMetricsByTime[current.Timestamp.UnixNano()], nodeMetricsByTime[next.Timestamp.UnixNano()])
+			total.add(newDiskIoDelta(current, next, reset))
+			count++
+		}
+		i++
+
+		if count == 0 {
+			continue
+		}
+
+		responses = append(responses, &dto.NodeDiskTimeSeriesResponse{
+			Device:             device,
+			AvgReadRate:        total.perSecond(total.readBytes),
+			AvgWriteRate:       total.perSecond(total.writeBytes),
+			AvgReadIops:        total.perSecond(total.reads),
+			AvgWriteIops:       total.perSecond(total.writes),
+			AvgReadMergedRate:  total.perSecond(total.mergedReads),
+			AvgWriteMergedRate: total.perSecond(total.mergedWrites),
+			AvgReadLatencyMs:   total.readLatencyMs(),
+			AvgWriteLatencyMs:  total.writeLatencyMs(),
+			AvgUtilPercent:     total.utilPercent(),
+			AvgQueueDepth:      total.queueDepth(),
+		})
+	}
+
+	return responses
+}
+
 // calculateNodeAverages 는 노드 메트릭들로부터 평균값들을 계산합니다.
 func (c *timeSeriesCalculator) calculateNodeAverages(metrics []*entity.NodeMetrics, window *utils.WindowSpec) (float64, int64, float64, float64, float64, float64, error) {
 	if len(metrics) < 2 {
@@ -90,36 +146,24 @@ func (c *timeSeriesCalculator) calculateNodeAverages(metrics []*entity.NodeMetri
 		memoryBytes := current.MemoryTotal - current.MemoryAvailable
 		totalMemoryBytes += memoryBytes
 
-		// 디스크 I/O 속도 계산
-		timeDiffSeconds := current.Timestamp.Sub(next.Timestamp).Seconds()
-		if timeDiffSeconds <= 0 {
-			timeDiffSeconds = 1
+		// 디스크, 네트워크 I/O 속도 계산
+		// 두 메트릭 사이에 노드가 재부팅되었으면 카운터가 0 부터 다시 누적된 것으로 계산합니다
+		timeDiff := current.Timestamp.Sub(next.Timestamp)
+		if timeDiff <= 0 {
+			timeDiff = time.Second
 		}
+		reset := newCounterReset(current, next)
 
-		diskReadRate := float64(current.DiskReadBytes-next.DiskReadBytes) / timeDiffSeconds
-		diskWriteRate := float64(current.DiskWriteBytes-next.DiskWriteBytes) / timeDiffSeconds
-		networkRxRate := float64(current.NetworkRxBytes-next.NetworkRxBytes) / timeDiffSeconds
-		networkTxRate := float64(current.NetworkTxBytes-next.NetworkTxBytes) / timeDiffSeconds
-
-		// 음수 값 처리
-		if diskReadRate < 0 {
-			diskReadRate = 0
-		}
-		if diskWriteRate < 0 {
-			diskWriteRate = 0
+		// 디스크, 네트워크를 수집하지 못한 메트릭이 있으면 해당 구간의 속도는 0으로 계산합니다
+		if current.DiskValid && next.DiskValid {
+			totalDiskReadRate += reset.rate(current.DiskReadBytes, next.DiskReadBytes, timeDiff)
+			totalDiskWriteRate += reset.rate(current.DiskWriteBytes, next.DiskWriteBytes, timeDiff)
 		}
-		if networkRxRate < 0 {
-			networkRxRate = 0
-		}
-		if networkTxRate < 0 {
-			networkTxRate = 0
+		if current.NetworkValid && next.NetworkValid {
+			totalNetworkRxRate += reset.rate(current.NetworkRxBytes, next.NetworkRxBytes, timeDiff)
+			totalNetworkTxRate += reset.rate(current.NetworkTxBytes, next.NetworkTxBytes, timeDiff)
 		}
 
-		totalDiskReadRate += diskReadRate
-		totalDiskWriteRate += diskWriteRate
-		totalNetworkRxRate += networkRxRate
-		totalNetworkTxRate += networkTxRate
-
 		count++
 	}
 
@@ -139,10 +183,14 @@ func (c *timeSeriesCalculator) calculateNodeAverages(metrics []*entity.NodeMetri
 }
 
 // calculateNodeCpuMillicores 는 두 개의 NodeMetrics 객체를 비교하여 CPU 사용량을 밀리코어 단위로 계산합니다.
+// 노드가 재부팅된 경우 부팅 이후의 누적 시간으로 계산하며, CPU 를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
 func (c *timeSeriesCalculator) calculateNodeCpuMillicores(latest, previous *entity.NodeMetrics) float64 {
-	if latest == nil || previous == nil {
+	if latest == nil || previous == nil || !latest.CPUValid || !previous.CPUValid {
 		return 0.0
 	}
+	if newCounterReset(latest, previous).rebooted {
+		previous = &entity.NodeMetrics{}
+	}
 
 	deltaCpuBusy := latest.CPUBusy - previous.CPUBusy
 	deltaCpuTotal := latest.CPUTotal - previous.CPUTotal
@@ -155,7 +203,7 @@ func (c *timeSeriesCalculator) calculateNodeCpuMillicores(latest, previous *enti
 }
 
 // CalculatePodTimeSeries 는 파드 메트릭들로부터 시계열 데이터를 계산합니다.
-func (c *timeSeriesCalculator) CalculatePodTimeSeries(podName string, metrics []*entity.PodMetrics, window *utils.WindowSpec) (*dto.PodTimeSeriesResponse, error) {
+func (c *timeSeriesCalculator) CalculatePodTimeSeries(podName string, metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (*dto.PodTimeSeriesResponse, error) {
 	if len(metrics) < 2 {
 		return nil, fmt.Errorf("insufficient data points for time series calculation (need at least 2, got %d)", len(metrics))
 	}
@@ -170,7 +218,7 @@ func (c *timeSeriesCalculator) CalculatePodTimeSeries(podName string, metrics []
 	startTime := window.GetStartTime(endTime)
 
 	// 평균값 계산
-	avgCpuMillicores, avgMemoryBytes, avgDiskReadRate, avgDiskWriteRate, avgNetworkRxRate, avgNetworkTxRate, err := c.calculatePodAverages(metrics, window)
+	avgCpuMillicores, avgMemoryBytes, avgDiskReadRate, avgDiskWriteRate, avgNetworkRxRate, avgNetworkTxRate, err := c.calculatePodAverages(metrics, window, memory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate averages: %w", err)
 	}
@@ -199,13 +247,14 @@ func (c *timeSeriesCalculator) CalculatePodTimeSeries(podName string, metrics []
 		AvgDiskWriteRate: avgDiskWriteRate,
 		AvgNetworkRxRate: avgNetworkRxRate,
 		AvgNetworkTxRate: avgNetworkTxRate,
+		HostNetwork:      firstMetric.HostNetwork,
 	}
 
 	return response, nil
 }
 
 // calculatePodAverages 는 파드 메트릭들로부터 평균값들을 계산합니다.
-func (c *timeSeriesCalculator) calculatePodAverages(metrics []*entity.PodMetrics, window *utils.WindowSpec) (float64, int64, float64, float64, float64, float64, error) {
+func (c *timeSeriesCalculator) calculatePodAverages(metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (float64, int64, float64, float64, float64, float64, error) {
 	if len(metrics) < 2 {
 		return 0, 0, 0, 0, 0, 0, fmt.Errorf("need at least 2 metrics for calculation")
 	}
@@ -234,7 +283,7 @@ func (c *timeSeriesCalculator) calculatePodAverages(metrics []*entity.PodMetrics
 		totalCpuMillicores += cpuMillicores
 
 		// 메모리 계산 (현재값 사용)
-		totalMemoryBytes += current.MemoryUsage
+		totalMemoryBytes += selectPodMemoryBytes(current, memory)
 
 		// 디스크 I/O 속도 계산
 		timeDiffSeconds := current.Timestamp.Sub(next.Timestamp).Seconds()
@@ -261,6 +310,14 @@ func (c *timeSeriesCalculator) calculatePodAverages(metrics []*entity.PodMetrics
 			networkTxRate = 0
 		}
 
+		// 디스크, 네트워크를 수집하지 못한 메트릭이 있으면 해당 구간의 속도는 0으로 계산합니다
+		if !current.DiskValid || !next.DiskValid {
+			diskReadRate, diskWriteRate = 0, 0
+		}
+		if !current.NetworkValid || !next.NetworkValid {
+			networkRxRate, networkTxRate = 0, 0
+		}
+
 		totalDiskReadRate += diskReadRate
 		totalDiskWriteRate += diskWriteRate
 		totalNetworkRxRate += networkRxRate
@@ -286,8 +343,9 @@ func (c *timeSeriesCalculator) calculatePodAverages(metrics []*entity.PodMetrics
 
 // calculatePodCpuMillicores 는 두 개의 PodMetrics 객체를 비교하여 CPU 사용량을 밀리코어 단위로 계산합니다.
 // Pod의 경우 CPU 사용량이 마이크로초(usec) 단위로 저장되므로 다른 계산 방식을 사용합니다.
+// 두 메트릭 중 CPU 를 수집하지 못한 메트릭이 있으면 0을 반환합니다.
 func (c *timeSeriesCalculator) calculatePodCpuMillicores(latest, previous *entity.PodMetrics) float64 {
-	if latest == nil || previous == nil {
+	if latest == nil || previous == nil || !latest.CPUValid || !previous.CPUValid {
 		return 0.0
 	}
 
@@ -303,7 +361,7 @@ func (c *timeSeriesCalculator) calculatePodCpuMillicores(latest, previous *entit
 }
 
 // CalculateNamespaceTimeSeries 는 네임스페이스의 파드 메트릭들로부터 시계열 데이터를 계산합니다.
-func (c *timeSeriesCalculator) CalculateNamespaceTimeSeries(namespaceName string, metrics []*entity.PodMetrics, window *utils.WindowSpec) (*dto.NamespaceTimeSeriesResponse, error) {
+func (c *timeSeriesCalculator) CalculateNamespaceTimeSeries(namespaceName string, metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (*dto.NamespaceTimeSeriesResponse, error) {
 	if len(metrics) < 2 {
 		return nil, fmt.Errorf("insufficient data points for time series calculation (need at least 2, got %d)", len(metrics))
 	}
@@ -318,7 +376,7 @@ func (c *timeSeriesCalculator) CalculateNamespaceTimeSeries(namespaceName string
 	startTime := window.GetStartTime(endTime)
 
 	// 평균값 계산
-	avgCpuMillicores, avgMemoryBytes, avgDiskReadRate, avgDiskWriteRate, avgNetworkRxRate, avgNetworkTxRate, err := c.calculateNamespaceAverages(metrics, window)
+	avgCpuMillicores, avgMemoryBytes, avgDiskReadRate, avgDiskWriteRate, avgNetworkRxRate, avgNetworkTxRate, err := c.calculateNamespaceAverages(metrics, window, memory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate averages: %w", err)
 	}
@@ -340,7 +398,7 @@ func (c *timeSeriesCalculator) CalculateNamespaceTimeSeries(namespaceName string
 }
 
 // calculateNamespaceAverages 는 네임스페이스의 파드 메트릭들로부터 평균값들을 계산합니다.
-func (c *timeSeriesCalculator) calculateNamespaceAverages(metrics []*entity.PodMetrics, window *utils.WindowSpec) (float64, int64, float64, float64, float64, float64, error) {
+func (c *timeSeriesCalculator) calculateNamespaceAverages(metrics []*entity.PodMetrics, window *utils.WindowSpec, memory utils.MemoryFlavor) (float64, int64, float64, float64, float64, float64, error) {
 	if len(metrics) < 2 {
 		return 0, 0, 0, 0, 0, 0, fmt.Errorf("need at least 2 metrics for calculation")
 	}
@@ -366,7 +424,7 @@ func (c *timeSeriesCalculator) calculateNamespaceAverages(metrics []*entity.PodM
 		}
 
 		// 파드별 평균값 계산
-		podAvgCpu, podAvgMem, podAvgDiskRead, podAvgDiskWrite, podAvgNetRx, podAvgNetTx, err := c.calculatePodAverages(podMetrics, window)
+		podAvgCpu, podAvgMem, podAvgDiskRead, podAvgDiskWrite, podAvgNetRx, podAvgNetTx, err := c.calculatePodAverages(podMetrics, window, memory)
 		if err != nil {
 			continue
 		}
@@ -375,8 +433,10 @@ func (c *timeSeriesCalculator) calculateNamespaceAverages(metrics []*entity.PodM
 		totalMemoryBytes += podAvgMem
 		totalDiskReadRate += podAvgDiskRead
 		totalDiskWriteRate += podAvgDiskWrite
-		totalNetworkRxRate += podAvgNetRx
-		totalNetworkTxRate += podAvgNetTx
+		if includeInNetworkTotals(podMetrics[0]) {
+			totalNetworkRxRate += podAvgNetRx
+			totalNetworkTxRate += podAvgNetTx
+		}
 		count++
 	}
 
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BreakdownSpec struct {
+	Device    bool `json:"device"`
+	Interface bool `json:"interface"`
+	CPU       bool `json:"cpu"`
+}
+
+// ParseBreakdown 는 쉼표로 구분된 breakdown 문자열을 파싱하여 BreakdownSpec을 반환합니다.
+// 예: "device", "device,interface", "cpu"
+func ParseBreakdown(breakdown string) (*BreakdownSpec, error) {
+	spec := &BreakdownSpec{}
+	if breakdown == "" {
+		return spec, nil
+	}
+
+	for _, item := range strings.Split(breakdown, ",") {
+		switch strings.TrimSpace(item) {
+		case "device":
+			spec.Device = true
+		case "interface":
+			spec.Interface = true
+		case "cpu":
+			spec.CPU = true
+		default:
+			return nil, fmt.Errorf("unsupported breakdown: %s (expected one of: device, interface, cpu)", item)
+		}
+	}
+
+	return spec, nil
+}
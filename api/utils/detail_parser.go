@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DetailSpec struct {
+	Memory bool `json:"memory"`
+}
+
+// ParseDetail 는 쉼표로 구분된 detail 문자열을 파싱하여 DetailSpec을 반환합니다.
+// 예: "memory"
+func ParseDetail(detail string) (*DetailSpec, error) {
+	spec := &DetailSpec{}
+	if detail == "" {
+		return spec, nil
+	}
+
+	for _, item := range strings.Split(detail, ",") {
+		switch strings.TrimSpace(item) {
+		case "memory":
+			spec.Memory = true
+		default:
+			return nil, fmt.Errorf("unsupported detail: %s (expected one of: memory)", item)
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,24 @@
+package utils
+
+import "fmt"
+
+type MemoryFlavor string
+
+const (
+	MemoryUsage      MemoryFlavor = "usage"       // memory.current (페이지 캐시 포함)
+	MemoryWorkingSet MemoryFlavor = "working_set" // usage - inactive_file (kubelet 기준)
+	MemoryRSS        MemoryFlavor = "rss"         // anon
+)
+
+// ParseMemoryFlavor 는 memory 쿼리 파라미터를 파싱하여 MemoryFlavor를 반환합니다.
+// 값이 없으면 기존과 동일하게 usage 를 사용합니다.
+func ParseMemoryFlavor(memory string) (MemoryFlavor, error) {
+	switch MemoryFlavor(memory) {
+	case "":
+		return MemoryUsage, nil
+	case MemoryUsage, MemoryWorkingSet, MemoryRSS:
+		return MemoryFlavor(memory), nil
+	default:
+		return "", fmt.Errorf("unsupported memory: %s (expected one of: usage, working_set, rss)", memory)
+	}
+}
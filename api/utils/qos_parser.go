@@ -0,0 +1,30 @@
+package utils
+
+import "fmt"
+
+// QoSClass 는 파드 목록을 필터링할 QoS 등급이며, 값은 pod_metrics.qos_class 에 저장된 값과 같습니다.
+type QoSClass string
+
+const (
+	QoSAll        QoSClass = ""
+	QoSGuaranteed QoSClass = "Guaranteed"
+	QoSBurstable  QoSClass = "Burstable"
+	QoSBestEffort QoSClass = "BestEffort"
+)
+
+// ParseQoSClass 는 qos 쿼리 파라미터를 파싱하여 QoSClass를 반환합니다.
+// 값이 없으면 모든 등급의 파드를 조회합니다.
+func ParseQoSClass(qos string) (QoSClass, error) {
+	switch qos {
+	case "":
+		return QoSAll, nil
+	case "guaranteed":
+		return QoSGuaranteed, nil
+	case "burstable":
+		return QoSBurstable, nil
+	case "besteffort":
+		return QoSBestEffort, nil
+	default:
+		return "", fmt.Errorf("unsupported qos: %s (expected one of: guaranteed, burstable, besteffort)", qos)
+	}
+}
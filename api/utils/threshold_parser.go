@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultThrottleThreshold 는 threshold 파라미터가 없을 때 사용하는 스로틀링 비율 임계값입니다.
+const DefaultThrottleThreshold = 0.1
+
+// ParseThreshold 는 threshold 쿼리 파라미터를 파싱하여 0과 1 사이의 비율을 반환합니다.
+// 값이 없으면 DefaultThrottleThreshold 를 사용합니다.
+func ParseThreshold(threshold string) (float64, error) {
+	if threshold == "" {
+		return DefaultThrottleThreshold, nil
+	}
+
+	value, err := strconv.ParseFloat(threshold, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid threshold: %s", threshold)
+	}
+
+	if value < 0 || value > 1 {
+		return 0, fmt.Errorf("threshold must be between 0 and 1, got: %s", threshold)
+	}
+
+	return value, nil
+}
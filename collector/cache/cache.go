@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// minRefreshGap 은 캐시에 없는 키 때문에 목록을 다시 조회하는 최소 간격입니다
+// 조회해도 찾을 수 없는 키(종료 중인 파드 등)로 kubelet, 컨테이너 런타임에 부하를 주지 않기 위함입니다
+const minRefreshGap = 5 * time.Second
+
+// Cache 는 kubelet 파드 목록, CRI 컨테이너 목록처럼 전체 목록을 조회하여 키로 찾는 캐시입니다
+// 주기적으로 갱신하며, 캐시에 없는 키를 조회하면 Run 에 갱신을 요청합니다
+type Cache[K comparable, V any] struct {
+	name      string
+	list      func() ([]V, error)
+	key       func(V) K
+	refreshCh chan struct{}
+
+	mu          sync.RWMutex
+	items       map[K]V
+	lastRefresh time.Time
+}
+
+// New 는 list 로 목록을 조회하고 key 로 각 항목의 키를 구하는 캐시를 생성합니다
+// name 은 갱신 실패 로그에 사용됩니다
+func New[K comparable, V any](name string, list func() ([]V, error), key func(V) K) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:      name,
+		list:      list,
+		key:       key,
+		refreshCh: make(chan struct{}, 1),
+		items:     make(map[K]V),
+	}
+}
+
+// Run 은 interval 마다, 그리고 Lookup 이 갱신을 요청할 때마다 목록을 갱신합니다
+func (c *Cache[K, V]) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	c.Refresh()
+	for {
+		select {
+		case <-ticker.C:
+		case <-c.refreshCh:
+		}
+		c.Refresh()
+	}
+}
+
+// Lookup 은 키에 해당하는 항목을 반환합니다
+// 캐시가 nil 이면 항상 false 를 반환하며, 목록을 조회하느라 수집이 지연되지 않도록 갱신을 기다리지 않습니다
+func (c *Cache[K, V]) Lookup(key K) (V, bool) {
+	if c == nil {
+		var zero V
+		return zero, false
+	}
+
+	c.mu.RLock()
+	item, ok := c.items[key]
+	stale := time.Since(c.lastRefresh) >= minRefreshGap
+	c.mu.RUnlock()
+	if !ok && stale {
+		// 새로 생성된 항목일 수 있으므로 Run 에 갱신을 요청하고, 다음 조회부터 반영합니다
+		select {
+		case c.refreshCh <- struct{}{}:
+		default:
+		}
+	}
+	return item, ok
+}
+
+// Refresh 는 목록을 다시 조회하여 캐시를 교체합니다
+func (c *Cache[K, V]) Refresh() {
+	items, err := c.list()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastRefresh = time.Now()
+	if err != nil {
+		slog.Error("failed to refresh "+c.name, "error", err)
+		return // 조회에 실패하면 이전 목록을 유지합니다
+	}
+
+	c.items = make(map[K]V, len(items))
+	for _, item := range items {
+		c.items[c.key(item)] = item
+	}
+}
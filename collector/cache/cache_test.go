@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupMissRequestsRefresh(t *testing.T) {
+	block := make(chan struct{})
+	c := New("items", func() ([]string, error) {
+		<-block
+		return []string{"a"}, nil
+	}, func(item string) string { return item })
+
+	// Run 과 같이 갱신 요청을 받아 목록을 갱신합니다
+	refreshed := make(chan struct{})
+	go func() {
+		<-c.refreshCh
+		c.Refresh()
+		close(refreshed)
+	}()
+
+	// 목록 조회가 끝나지 않아도 캐시에 없는 키는 기다리지 않고 false 를 반환합니다
+	if _, ok := c.Lookup("a"); ok {
+		t.Fatal("Lookup(a) before refresh = true, want false")
+	}
+
+	close(block)
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("Lookup miss did not request a refresh")
+	}
+
+	if item, ok := c.Lookup("a"); !ok || item != "a" {
+		t.Errorf("Lookup(a) after refresh = %q, %v, want a, true", item, ok)
+	}
+}
@@ -0,0 +1,94 @@
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type Version int
+
+const (
+	V1 Version = 1
+	V2 Version = 2
+)
+
+type Driver string
+
+const (
+	DriverCgroupfs Driver = "cgroupfs"
+	DriverSystemd  Driver = "systemd"
+)
+
+// discoveryController 는 cgroup v1 에서 파드/컨테이너 경로 탐색에 사용하는 컨트롤러입니다
+// cgroup v1 의 각 컨트롤러 계층은 동일한 상대 경로를 가지므로 하나의 계층만 탐색하면 됩니다
+const discoveryController = "memory"
+
+var ErrKubepodsNotFound = errors.New("kubepods cgroup not found")
+
+// Layout 은 노드의 cgroup 계층 구조(버전과 kubelet cgroup 드라이버)를 나타냅니다
+type Layout struct {
+	Root    string
+	Version Version
+	Driver  Driver
+}
+
+// DetectLayout 은 root 아래의 디렉토리 구조로 cgroup 버전과 드라이버를 판별합니다
+func DetectLayout(root string) (Layout, error) {
+	layout := Layout{Root: root, Version: V1}
+
+	// cgroup v2 통합 계층은 루트에 cgroup.controllers 파일이 존재합니다
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err == nil {
+		layout.Version = V2
+	}
+
+	// systemd 드라이버는 kubepods.slice, cgroupfs 드라이버는 kubepods 디렉토리를 사용합니다
+	switch {
+	case isDir(layout.Dir(discoveryController, "kubepods.slice")):
+		layout.Driver = DriverSystemd
+	case isDir(layout.Dir(discoveryController, "kubepods")):
+		layout.Driver = DriverCgroupfs
+	default:
+		return Layout{}, fmt.Errorf("%w under %s (cgroup v%d)", ErrKubepodsNotFound, root, layout.Version)
+	}
+
+	return layout, nil
+}
+
+// Dir 은 주어진 컨트롤러에서 cgroup 상대 경로에 해당하는 디렉토리를 반환합니다
+// cgroup v2 는 통합 계층이므로 컨트롤러와 관계없이 같은 디렉토리를 반환합니다
+func (l Layout) Dir(controller, path string) string {
+	if l.Version == V2 {
+		return filepath.Join(l.Root, path)
+	}
+	return filepath.Join(l.Root, controller, path)
+}
+
+// Procs 는 cgroup 상대 경로에 직접 속한 프로세스의 PID 목록을 반환합니다
+func (l Layout) Procs(path string) ([]int, error) {
+	f, err := os.Open(filepath.Join(l.Dir(discoveryController, path), "cgroup.procs"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var pids []int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		pid, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+		if err != nil || pid <= 0 {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	return pids, scanner.Err()
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
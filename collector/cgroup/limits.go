@@ -0,0 +1,183 @@
+package cgroup
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// QoSClass 는 kubelet 이 파드에 부여한 QoS 등급입니다
+type QoSClass string
+
+const (
+	QoSGuaranteed QoSClass = "Guaranteed"
+	QoSBurstable  QoSClass = "Burstable"
+	QoSBestEffort QoSClass = "BestEffort"
+)
+
+// QoSClass 는 파드 cgroup 경로에서 QoS 등급을 판별합니다
+// kubelet 은 Burstable, BestEffort 파드를 burstable, besteffort 하위 cgroup 에 두고 Guaranteed 파드는 kubepods 바로 아래에 둡니다
+// 예: /kubepods/burstable/pod<uid>, /kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod<uid>.slice
+func (p PodCgroup) QoSClass() QoSClass {
+	for _, name := range strings.Split(p.Path, "/") {
+		switch name {
+		case "burstable", "kubepods-burstable.slice":
+			return QoSBurstable
+		case "besteffort", "kubepods-besteffort.slice":
+			return QoSBestEffort
+		}
+	}
+	return QoSGuaranteed
+}
+
+// Limits 는 cgroup 에 설정된 CPU, 메모리 제한입니다
+// 제한이 없으면 0 입니다
+type Limits struct {
+	CPUQuotaUsec  uint64 // 주기마다 사용할 수 있는 CPU 시간
+	CPUPeriodUsec uint64
+	CPUWeight     uint64 // 1~10000, CPU requests 에서 계산된 상대 가중치
+	MemoryMax     uint64
+	MemoryHigh    uint64 // cgroup v1 에는 없으므로 항상 0
+}
+
+// v1MemoryUnlimited 는 cgroup v1 memory.limit_in_bytes 가 제한 없음을 나타내는 최솟값입니다
+// 커널은 제한 없음을 페이지 크기로 내림한 MaxInt64 로 표시하므로 64KiB 페이지까지 고려합니다
+const v1MemoryUnlimited = math.MaxInt64 &^ (1<<16 - 1)
+
+// ReadLimits 는 cgroup 상대 경로의 CPU, 메모리 제한 파일을 읽습니다
+// cgroup v1 의 cpu.shares 는 kubelet, runc 와 같은 방식으로 cgroup v2 의 cpu.weight 로 변환합니다
+func ReadLimits(layout Layout, path string) (Limits, error) {
+	cpuDir, memoryDir := layout.Dir("cpu", path), layout.Dir("memory", path)
+	if layout.Version == V2 {
+		return readLimitsV2(cpuDir, memoryDir)
+	}
+	return readLimitsV1(cpuDir, memoryDir)
+}
+
+func readLimitsV2(cpuDir, memoryDir string) (Limits, error) {
+	var limits Limits
+
+	// cpu.max 는 "<quota> <period>" 형식이며 quota 가 "max" 이면 제한이 없습니다
+	fields, err := readFields(filepath.Join(cpuDir, "cpu.max"))
+	if err != nil {
+		return Limits{}, err
+	}
+	if len(fields) != 2 {
+		return Limits{}, fmt.Errorf("unexpected cpu.max format: %q", strings.Join(fields, " "))
+	}
+	if limits.CPUQuotaUsec, err = parseMax(fields[0]); err != nil {
+		return Limits{}, fmt.Errorf("failed to parse cpu.max: %w", err)
+	}
+	if limits.CPUPeriodUsec, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return Limits{}, fmt.Errorf("failed to parse cpu.max: %w", err)
+	}
+
+	if limits.CPUWeight, err = readUint(filepath.Join(cpuDir, "cpu.weight")); err != nil {
+		return Limits{}, err
+	}
+	if limits.MemoryMax, err = readMax(filepath.Join(memoryDir, "memory.max")); err != nil {
+		return Limits{}, err
+	}
+	if limits.MemoryHigh, err = readMax(filepath.Join(memoryDir, "memory.high")); err != nil {
+		return Limits{}, err
+	}
+	return limits, nil
+}
+
+func readLimitsV1(cpuDir, memoryDir string) (Limits, error) {
+	var limits Limits
+
+	// cpu.cfs_quota_us 가 -1 이면 제한이 없습니다
+	quota, err := readInt(filepath.Join(cpuDir, "cpu.cfs_quota_us"))
+	if err != nil {
+		return Limits{}, err
+	}
+	if quota > 0 {
+		limits.CPUQuotaUsec = uint64(quota)
+	}
+	if limits.CPUPeriodUsec, err = readUint(filepath.Join(cpuDir, "cpu.cfs_period_us")); err != nil {
+		return Limits{}, err
+	}
+
+	shares, err := readUint(filepath.Join(cpuDir, "cpu.shares"))
+	if err != nil {
+		return Limits{}, err
+	}
+	limits.CPUWeight = sharesToWeight(shares)
+
+	memoryMax, err := readUint(filepath.Join(memoryDir, "memory.limit_in_bytes"))
+	if err != nil {
+		return Limits{}, err
+	}
+	if memoryMax < v1MemoryUnlimited {
+		limits.MemoryMax = memoryMax
+	}
+	return limits, nil
+}
+
+// sharesToWeight 는 cgroup v1 cpu.shares(2~262144)를 cgroup v2 cpu.weight(1~10000)로 변환합니다
+func sharesToWeight(shares uint64) uint64 {
+	if shares < 2 {
+		shares = 2
+	}
+	if shares > 262144 {
+		shares = 262144
+	}
+	return 1 + ((shares-2)*9999)/262142
+}
+
+func readFields(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(data)), nil
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return value, nil
+}
+
+func readInt(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return value, nil
+}
+
+// readMax 는 "max" 또는 정수 값 하나를 담은 cgroup v2 파일을 읽습니다
+func readMax(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := parseMax(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return value, nil
+}
+
+// parseMax 는 "max" 를 제한 없음(0)으로 변환합니다
+func parseMax(value string) (uint64, error) {
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
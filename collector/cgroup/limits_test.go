@@ -0,0 +1,95 @@
+package cgroup
+
+import (
+	"testing"
+)
+
+func TestPodCgroupQoSClass(t *testing.T) {
+	tests := []struct {
+		path string
+		want QoSClass
+	}{
+		{"/kubepods/pod" + guaranteedUID, QoSGuaranteed},
+		{"/kubepods/burstable/pod" + burstableUID, QoSBurstable},
+		{"/kubepods/besteffort/pod" + bestEffortUID, QoSBestEffort},
+		{"/kubepods.slice/kubepods-pod" + systemdUID(guaranteedUID) + ".slice", QoSGuaranteed},
+		{"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice", QoSBurstable},
+		{"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + systemdUID(bestEffortUID) + ".slice", QoSBestEffort},
+	}
+
+	for _, tt := range tests {
+		if got := (PodCgroup{Path: tt.path}).QoSClass(); got != tt.want {
+			t.Errorf("QoSClass(%s) = %s, want %s", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestReadLimits(t *testing.T) {
+	podPath := "/kubepods/burstable/pod" + burstableUID
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  Limits
+	}{
+		{
+			name: "v2 limited",
+			files: map[string]string{
+				"cgroup.controllers":         "cpu memory",
+				podPath[1:] + "/cpu.max":     "50000 100000\n",
+				podPath[1:] + "/cpu.weight":  "20\n",
+				podPath[1:] + "/memory.max":  "268435456\n",
+				podPath[1:] + "/memory.high": "max\n",
+			},
+			want: Limits{CPUQuotaUsec: 50000, CPUPeriodUsec: 100000, CPUWeight: 20, MemoryMax: 268435456},
+		},
+		{
+			name: "v2 unlimited",
+			files: map[string]string{
+				"cgroup.controllers":         "cpu memory",
+				podPath[1:] + "/cpu.max":     "max 100000\n",
+				podPath[1:] + "/cpu.weight":  "1\n",
+				podPath[1:] + "/memory.max":  "max\n",
+				podPath[1:] + "/memory.high": "max\n",
+			},
+			want: Limits{CPUPeriodUsec: 100000, CPUWeight: 1},
+		},
+		{
+			name: "v1 limited",
+			files: map[string]string{
+				"cpu" + podPath + "/cpu.cfs_quota_us":         "50000\n",
+				"cpu" + podPath + "/cpu.cfs_period_us":        "100000\n",
+				"cpu" + podPath + "/cpu.shares":               "512\n",
+				"memory" + podPath + "/memory.limit_in_bytes": "268435456\n",
+			},
+			want: Limits{CPUQuotaUsec: 50000, CPUPeriodUsec: 100000, CPUWeight: 20, MemoryMax: 268435456},
+		},
+		{
+			name: "v1 unlimited",
+			files: map[string]string{
+				"cpu" + podPath + "/cpu.cfs_quota_us":         "-1\n",
+				"cpu" + podPath + "/cpu.cfs_period_us":        "100000\n",
+				"cpu" + podPath + "/cpu.shares":               "2\n",
+				"memory" + podPath + "/memory.limit_in_bytes": "9223372036854771712\n",
+			},
+			want: Limits{CPUPeriodUsec: 100000, CPUWeight: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := buildTree(t, nil, tt.files)
+			layout, err := DetectLayout(root)
+			if err != nil {
+				t.Fatalf("DetectLayout() error = %v", err)
+			}
+
+			limits, err := ReadLimits(layout, podPath)
+			if err != nil {
+				t.Fatalf("ReadLimits() error = %v", err)
+			}
+			if limits != tt.want {
+				t.Errorf("ReadLimits() = %+v, want %+v", limits, tt.want)
+			}
+		})
+	}
+}
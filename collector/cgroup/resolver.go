@@ -0,0 +1,138 @@
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// PodCgroup 은 파드 단위 cgroup 입니다
+// Path 는 cgroup 계층 루트 기준의 상대 경로입니다 (예: /kubepods/burstable/pod<uid>)
+type PodCgroup struct {
+	UID  string
+	Path string
+}
+
+// ContainerCgroup 은 파드 cgroup 하위의 컨테이너 cgroup 입니다
+type ContainerCgroup struct {
+	ID   string
+	Path string
+}
+
+// PathResolver 는 kubelet cgroup 드라이버별 파드/컨테이너 cgroup 경로 규칙을 구현합니다
+type PathResolver interface {
+	PodCgroups() ([]PodCgroup, error)
+	ContainerCgroups(pod PodCgroup) ([]ContainerCgroup, error)
+}
+
+// NewPathResolver 는 레이아웃의 드라이버에 맞는 PathResolver 를 반환합니다
+func NewPathResolver(layout Layout) PathResolver {
+	if layout.Driver == DriverSystemd {
+		return &systemdResolver{layout: layout}
+	}
+	return &cgroupfsResolver{layout: layout}
+}
+
+// containerPattern 은 컨테이너 cgroup 디렉토리 이름에서 64자리 컨테이너 ID 를 추출합니다
+// cgroupfs: <id>, systemd: cri-containerd-<id>.scope, crio-<id>.scope, docker-<id>.scope
+var containerPattern = regexp.MustCompile(`^(?:(?:cri-containerd|crio|docker)-)?([0-9a-f]{64})(?:\.scope)?$`)
+
+// cgroupfsResolver 는 cgroupfs 드라이버의 경로 규칙을 구현합니다
+// 예: /kubepods/burstable/pod<uid>/<container-id>
+type cgroupfsResolver struct {
+	layout Layout
+}
+
+func (r *cgroupfsResolver) PodCgroups() ([]PodCgroup, error) {
+	return walkPodCgroups(r.layout, "/kubepods", func(name string) (string, bool) {
+		if len(name) > 3 && strings.HasPrefix(name, "pod") {
+			return name[3:], true
+		}
+		return "", false
+	})
+}
+
+func (r *cgroupfsResolver) ContainerCgroups(pod PodCgroup) ([]ContainerCgroup, error) {
+	return listContainerCgroups(r.layout, pod)
+}
+
+// systemdResolver 는 systemd 드라이버의 경로 규칙을 구현합니다
+// 예: /kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod<uid>.slice/cri-containerd-<id>.scope
+// systemd 유닛 이름에서는 UID 의 '-' 가 '_' 로 치환됩니다
+type systemdResolver struct {
+	layout Layout
+}
+
+var systemdPodPattern = regexp.MustCompile(`^kubepods(?:-[a-z]+)?-pod([0-9a-f_]+)\.slice$`)
+
+func (r *systemdResolver) PodCgroups() ([]PodCgroup, error) {
+	return walkPodCgroups(r.layout, "/kubepods.slice", func(name string) (string, bool) {
+		matches := systemdPodPattern.FindStringSubmatch(name)
+		if matches == nil {
+			return "", false
+		}
+		return strings.ReplaceAll(matches[1], "_", "-"), true
+	})
+}
+
+func (r *systemdResolver) ContainerCgroups(pod PodCgroup) ([]ContainerCgroup, error) {
+	return listContainerCgroups(r.layout, pod)
+}
+
+// walkPodCgroups 는 kubepods 경로 아래를 순회하며 parseUID 가 UID 를 반환하는 디렉토리를 파드 cgroup 으로 수집합니다
+func walkPodCgroups(layout Layout, kubepodsPath string, parseUID func(name string) (string, bool)) ([]PodCgroup, error) {
+	base := layout.Dir(discoveryController, "/")
+
+	var pods []PodCgroup
+	err := filepath.WalkDir(layout.Dir(discoveryController, kubepodsPath), func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		uid, ok := parseUID(entry.Name())
+		if !ok {
+			return nil
+		}
+
+		rel, err := filepath.Rel(base, path)
+		if err != nil {
+			return err
+		}
+		pods = append(pods, PodCgroup{UID: uid, Path: "/" + rel})
+
+		// 파드 하위의 컨테이너 cgroup 은 탐색하지 않습니다
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods, nil
+}
+
+// listContainerCgroups 는 파드 cgroup 바로 아래의 컨테이너 cgroup 목록을 반환합니다
+func listContainerCgroups(layout Layout, pod PodCgroup) ([]ContainerCgroup, error) {
+	entries, err := os.ReadDir(layout.Dir(discoveryController, pod.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []ContainerCgroup
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		matches := containerPattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		containers = append(containers, ContainerCgroup{
+			ID:   matches[1],
+			Path: filepath.Join(pod.Path, entry.Name()),
+		})
+	}
+	return containers, nil
+}
@@ -0,0 +1,232 @@
+package cgroup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const (
+	burstableUID  = "8f0c2a4e-1b3d-4c5e-9f6a-7b8c9d0e1f2a"
+	guaranteedUID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	bestEffortUID = "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9"
+)
+
+var (
+	containerA = strings.Repeat("a", 64)
+	containerB = strings.Repeat("b", 64)
+	containerC = strings.Repeat("c", 64)
+)
+
+// buildTree 는 root 아래에 주어진 디렉토리와 파일(내용 포함)로 구성된 fixture 트리를 생성합니다
+func buildTree(t *testing.T, dirs []string, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for _, dir := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func systemdUID(uid string) string {
+	return strings.ReplaceAll(uid, "-", "_")
+}
+
+func TestPathResolver(t *testing.T) {
+	tests := []struct {
+		name           string
+		dirs           []string
+		files          map[string]string
+		wantVersion    Version
+		wantDriver     Driver
+		wantPods       []PodCgroup
+		wantContainers map[string][]ContainerCgroup
+	}{
+		{
+			name: "cgroupfs v2",
+			dirs: []string{
+				"kubepods/burstable/pod" + burstableUID + "/" + containerA,
+				"kubepods/burstable/pod" + burstableUID + "/" + containerB,
+				"kubepods/pod" + guaranteedUID + "/" + containerC,
+				"kubepods/besteffort",
+				"system.slice/containerd.service",
+			},
+			files:       map[string]string{"cgroup.controllers": "cpu memory io pids"},
+			wantVersion: V2,
+			wantDriver:  DriverCgroupfs,
+			wantPods: []PodCgroup{
+				{UID: burstableUID, Path: "/kubepods/burstable/pod" + burstableUID},
+				{UID: guaranteedUID, Path: "/kubepods/pod" + guaranteedUID},
+			},
+			wantContainers: map[string][]ContainerCgroup{
+				burstableUID: {
+					{ID: containerA, Path: "/kubepods/burstable/pod" + burstableUID + "/" + containerA},
+					{ID: containerB, Path: "/kubepods/burstable/pod" + burstableUID + "/" + containerB},
+				},
+				guaranteedUID: {
+					{ID: containerC, Path: "/kubepods/pod" + guaranteedUID + "/" + containerC},
+				},
+			},
+		},
+		{
+			name: "systemd v2",
+			dirs: []string{
+				"kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice/cri-containerd-" + containerA + ".scope",
+				"kubepods.slice/kubepods-pod" + systemdUID(guaranteedUID) + ".slice/crio-" + containerC + ".scope",
+				"kubepods.slice/kubepods-pod" + systemdUID(guaranteedUID) + ".slice/crio-conmon-" + containerC + ".scope",
+				"kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + systemdUID(bestEffortUID) + ".slice/docker-" + containerB + ".scope",
+			},
+			files:       map[string]string{"cgroup.controllers": "cpu memory io pids"},
+			wantVersion: V2,
+			wantDriver:  DriverSystemd,
+			wantPods: []PodCgroup{
+				{UID: bestEffortUID, Path: "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + systemdUID(bestEffortUID) + ".slice"},
+				{UID: burstableUID, Path: "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice"},
+				{UID: guaranteedUID, Path: "/kubepods.slice/kubepods-pod" + systemdUID(guaranteedUID) + ".slice"},
+			},
+			wantContainers: map[string][]ContainerCgroup{
+				bestEffortUID: {
+					{ID: containerB, Path: "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + systemdUID(bestEffortUID) + ".slice/docker-" + containerB + ".scope"},
+				},
+				burstableUID: {
+					{ID: containerA, Path: "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice/cri-containerd-" + containerA + ".scope"},
+				},
+				guaranteedUID: {
+					{ID: containerC, Path: "/kubepods.slice/kubepods-pod" + systemdUID(guaranteedUID) + ".slice/crio-" + containerC + ".scope"},
+				},
+			},
+		},
+		{
+			name: "cgroupfs v1",
+			dirs: []string{
+				"memory/kubepods/besteffort/pod" + bestEffortUID + "/" + containerA,
+				"cpu,cpuacct/kubepods/besteffort/pod" + bestEffortUID + "/" + containerA,
+			},
+			wantVersion: V1,
+			wantDriver:  DriverCgroupfs,
+			wantPods: []PodCgroup{
+				{UID: bestEffortUID, Path: "/kubepods/besteffort/pod" + bestEffortUID},
+			},
+			wantContainers: map[string][]ContainerCgroup{
+				bestEffortUID: {
+					{ID: containerA, Path: "/kubepods/besteffort/pod" + bestEffortUID + "/" + containerA},
+				},
+			},
+		},
+		{
+			name: "systemd v1",
+			dirs: []string{
+				"memory/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice/cri-containerd-" + containerB + ".scope",
+			},
+			wantVersion: V1,
+			wantDriver:  DriverSystemd,
+			wantPods: []PodCgroup{
+				{UID: burstableUID, Path: "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice"},
+			},
+			wantContainers: map[string][]ContainerCgroup{
+				burstableUID: {
+					{ID: containerB, Path: "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod" + systemdUID(burstableUID) + ".slice/cri-containerd-" + containerB + ".scope"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := buildTree(t, tt.dirs, tt.files)
+
+			layout, err := DetectLayout(root)
+			if err != nil {
+				t.Fatalf("DetectLayout() error = %v", err)
+			}
+			if layout.Version != tt.wantVersion || layout.Driver != tt.wantDriver {
+				t.Fatalf("DetectLayout() = v%d/%s, want v%d/%s", layout.Version, layout.Driver, tt.wantVersion, tt.wantDriver)
+			}
+
+			resolver := NewPathResolver(layout)
+			pods, err := resolver.PodCgroups()
+			if err != nil {
+				t.Fatalf("PodCgroups() error = %v", err)
+			}
+			sort.Slice(pods, func(i, j int) bool { return pods[i].UID < pods[j].UID })
+			want := append([]PodCgroup(nil), tt.wantPods...)
+			sort.Slice(want, func(i, j int) bool { return want[i].UID < want[j].UID })
+			if !reflect.DeepEqual(pods, want) {
+				t.Fatalf("PodCgroups() = %v, want %v", pods, want)
+			}
+
+			for _, pod := range pods {
+				containers, err := resolver.ContainerCgroups(pod)
+				if err != nil {
+					t.Fatalf("ContainerCgroups(%s) error = %v", pod.UID, err)
+				}
+				if !reflect.DeepEqual(containers, tt.wantContainers[pod.UID]) {
+					t.Errorf("ContainerCgroups(%s) = %v, want %v", pod.UID, containers, tt.wantContainers[pod.UID])
+				}
+			}
+		})
+	}
+}
+
+func TestDetectLayoutWithoutKubepods(t *testing.T) {
+	root := buildTree(t, []string{"system.slice"}, map[string]string{"cgroup.controllers": "cpu memory"})
+
+	if _, err := DetectLayout(root); !errors.Is(err, ErrKubepodsNotFound) {
+		t.Fatalf("DetectLayout() error = %v, want %v", err, ErrKubepodsNotFound)
+	}
+}
+
+func TestLayoutProcs(t *testing.T) {
+	podPath := "/kubepods/pod" + guaranteedUID
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name: "v2",
+			files: map[string]string{
+				"cgroup.controllers":          "cpu memory",
+				podPath[1:] + "/cgroup.procs": "1234\n5678\n",
+			},
+		},
+		{
+			name: "v1",
+			files: map[string]string{
+				"memory" + podPath + "/cgroup.procs": "1234\n5678\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := buildTree(t, nil, tt.files)
+			layout, err := DetectLayout(root)
+			if err != nil {
+				t.Fatalf("DetectLayout() error = %v", err)
+			}
+
+			pids, err := layout.Procs(podPath)
+			if err != nil {
+				t.Fatalf("Procs() error = %v", err)
+			}
+			if want := []int{1234, 5678}; !reflect.DeepEqual(pids, want) {
+				t.Errorf("Procs() = %v, want %v", pids, want)
+			}
+		})
+	}
+}
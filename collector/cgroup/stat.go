@@ -0,0 +1,165 @@
+package cgroup
+
+import (
+	"fmt"
+
+	"github.com/containerd/cgroups/v3/cgroup1"
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/containerd/cgroups/v3/cgroup2/stats"
+)
+
+// Stat 은 cgroup 상대 경로의 통계를 cgroup v2 형식으로 반환합니다
+// cgroup v1 통계는 v2 형식으로 변환하여 이후 처리가 버전과 무관하도록 합니다
+func Stat(layout Layout, path string) (*stats.Metrics, error) {
+	if layout.Version == V2 {
+		manager, err := cgroup2.Load(path, cgroup2.WithMountpoint(layout.Root))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load cgroup manager: %w", err)
+		}
+		metrics, err := manager.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cgroup stats: %w", err)
+		}
+		return metrics, nil
+	}
+
+	control, err := cgroup1.Load(cgroup1.StaticPath(path), cgroup1.WithHierarchy(hierarchyV1(layout.Root)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cgroup v1 control: %w", err)
+	}
+	metrics, err := control.Stat(cgroup1.IgnoreNotExist)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cgroup v1 stats: %w", err)
+	}
+	return convertV1Metrics(metrics), nil
+}
+
+// hierarchyV1 은 메트릭 수집에 필요한 cgroup v1 컨트롤러만으로 구성된 계층을 반환합니다
+func hierarchyV1(root string) cgroup1.Hierarchy {
+	return func() ([]cgroup1.Subsystem, error) {
+		return []cgroup1.Subsystem{
+			cgroup1.NewPids(root),
+			cgroup1.NewCpu(root),
+			cgroup1.NewCpuacct(root),
+			cgroup1.NewMemory(root),
+			cgroup1.NewBlkio(root),
+		}, nil
+	}
+}
+
+// convertV1Metrics 는 cgroup v1 통계를 대응하는 cgroup v2 통계 필드로 변환합니다
+// v1 컨트롤러가 마운트되지 않아 통계가 없으면 v2 필드도 nil 로 두어 호출자가 수집 실패로 처리할 수 있도록 합니다
+func convertV1Metrics(m *v1.Metrics) *stats.Metrics {
+	out := &stats.Metrics{}
+
+	if m.Pids != nil {
+		out.Pids = &stats.PidsStat{
+			Current: m.Pids.Current,
+			Limit:   m.Pids.Limit,
+		}
+	}
+
+	// cgroup v1 의 CPU 시간은 나노초 단위입니다
+	// 사용 시간은 cpuacct, 스로틀링은 cpu 컨트롤러에서 읽으므로 cpuacct 가 없으면 CPU 통계가 없는 것으로 봅니다
+	if m.CPU != nil && m.CPU.Usage != nil {
+		out.CPU = &stats.CPUStat{
+			UsageUsec:  m.CPU.Usage.Total / 1000,
+			UserUsec:   m.CPU.Usage.User / 1000,
+			SystemUsec: m.CPU.Usage.Kernel / 1000,
+		}
+		if m.CPU.Throttling != nil {
+			out.CPU.NrPeriods = m.CPU.Throttling.Periods
+			out.CPU.NrThrottled = m.CPU.Throttling.ThrottledPeriods
+			out.CPU.ThrottledUsec = m.CPU.Throttling.ThrottledTime / 1000
+		}
+	}
+
+	if m.Memory != nil {
+		out.Memory = &stats.MemoryStat{
+			Anon:          m.Memory.TotalRSS,
+			File:          m.Memory.TotalCache,
+			FileMapped:    m.Memory.TotalMappedFile,
+			FileDirty:     m.Memory.TotalDirty,
+			FileWriteback: m.Memory.TotalWriteback,
+			InactiveAnon:  m.Memory.TotalInactiveAnon,
+			ActiveAnon:    m.Memory.TotalActiveAnon,
+			InactiveFile:  m.Memory.TotalInactiveFile,
+			ActiveFile:    m.Memory.TotalActiveFile,
+			Unevictable:   m.Memory.TotalUnevictable,
+			Pgfault:       m.Memory.TotalPgFault,
+			Pgmajfault:    m.Memory.TotalPgMajFault,
+		}
+		if m.Memory.Usage != nil {
+			out.Memory.Usage = m.Memory.Usage.Usage
+			out.Memory.UsageLimit = m.Memory.Usage.Limit
+			out.Memory.MaxUsage = m.Memory.Usage.Max
+		}
+		// cgroup v1 은 커널 메모리를 세분화하지 않으므로 kmem 사용량 전체를 slab 으로 취급합니다
+		if m.Memory.Kernel != nil {
+			out.Memory.Slab = m.Memory.Kernel.Usage
+		}
+		if m.Memory.KernelTCP != nil {
+			out.Memory.Sock = m.Memory.KernelTCP.Usage
+		}
+		// cgroup v1 의 memsw 사용량은 메모리와 스왑의 합계입니다
+		if m.Memory.Swap != nil && m.Memory.Swap.Usage > out.Memory.Usage {
+			out.Memory.SwapUsage = m.Memory.Swap.Usage - out.Memory.Usage
+			out.Memory.SwapLimit = m.Memory.Swap.Limit
+		}
+	}
+
+	// cgroup v1 에는 memory.events 가 없으므로 제한 도달 횟수(failcnt)와 oom_control 의 oom_kill 로 대체합니다
+	// oom 이벤트에 해당하는 카운터는 없으므로 0 으로 둡니다
+	if m.Memory != nil && m.Memory.Usage != nil {
+		out.MemoryEvents = &stats.MemoryEvents{Max: m.Memory.Usage.Failcnt}
+		if m.MemoryOomControl != nil {
+			out.MemoryEvents.OomKill = m.MemoryOomControl.OomKill
+		}
+	}
+
+	if m.Blkio != nil {
+		out.Io = &stats.IOStat{Usage: convertV1BlkioEntries(m.Blkio)}
+	}
+
+	return out
+}
+
+// convertV1BlkioEntries 는 장치(major:minor)별 blkio 항목을 cgroup v2 io.stat 항목으로 합칩니다
+func convertV1BlkioEntries(blkio *v1.BlkIOStat) []*stats.IOEntry {
+	entries := make(map[[2]uint64]*stats.IOEntry)
+	var order [][2]uint64
+	entryFor := func(major, minor uint64) *stats.IOEntry {
+		key := [2]uint64{major, minor}
+		entry, ok := entries[key]
+		if !ok {
+			entry = &stats.IOEntry{Major: major, Minor: minor}
+			entries[key] = entry
+			order = append(order, key)
+		}
+		return entry
+	}
+
+	for _, e := range blkio.IoServiceBytesRecursive {
+		switch e.Op {
+		case "Read":
+			entryFor(e.Major, e.Minor).Rbytes = e.Value
+		case "Write":
+			entryFor(e.Major, e.Minor).Wbytes = e.Value
+		}
+	}
+	for _, e := range blkio.IoServicedRecursive {
+		switch e.Op {
+		case "Read":
+			entryFor(e.Major, e.Minor).Rios = e.Value
+		case "Write":
+			entryFor(e.Major, e.Minor).Wios = e.Value
+		}
+	}
+
+	result := make([]*stats.IOEntry, 0, len(order))
+	for _, key := range order {
+		result = append(result, entries[key])
+	}
+	return result
+}
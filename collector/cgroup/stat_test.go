@@ -0,0 +1,26 @@
+package cgroup
+
+import (
+	"testing"
+
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
+)
+
+func TestConvertV1MetricsMissingControllers(t *testing.T) {
+	out := convertV1Metrics(&v1.Metrics{
+		Memory: &v1.MemoryStat{
+			Usage: &v1.MemoryEntry{Usage: 4096, Failcnt: 3},
+		},
+		MemoryOomControl: &v1.MemoryOomControl{OomKill: 2},
+	})
+
+	if out.CPU != nil || out.Pids != nil || out.Io != nil {
+		t.Errorf("convertV1Metrics() = cpu %v, pids %v, io %v, want nil for missing controllers", out.CPU, out.Pids, out.Io)
+	}
+	if out.Memory == nil || out.Memory.Usage != 4096 {
+		t.Fatalf("convertV1Metrics() memory = %v, want usage 4096", out.Memory)
+	}
+	if out.MemoryEvents == nil || out.MemoryEvents.Max != 3 || out.MemoryEvents.OomKill != 2 || out.MemoryEvents.Oom != 0 {
+		t.Errorf("convertV1Metrics() memory events = %v, want max 3, oom_kill 2, oom 0", out.MemoryEvents)
+	}
+}
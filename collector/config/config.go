@@ -0,0 +1,240 @@
+package config
+
+import (
+	"log"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SampleInterval 은 수집기가 스스로 메트릭을 수집하는 주기입니다
+var SampleInterval time.Duration
+
+// HistorySize 는 링 버퍼에 보관하는 최대 샘플 개수입니다
+var HistorySize int
+
+// PushURL 은 push 모드에서 샘플을 전송할 aggregator ingest 엔드포인트입니다
+// 비어 있으면 push 모드를 사용하지 않습니다
+var PushURL string
+
+// PushInterval 은 대기 중인 샘플을 전송하는 주기입니다
+var PushInterval time.Duration
+
+// PushQueueSize 는 전송 대기 큐에 보관하는 최대 샘플 개수입니다
+// 가득 차면 가장 오래된 샘플부터 버립니다
+var PushQueueSize int
+
+// PushMaxBackoff 는 전송 실패 시 재시도 간격의 상한입니다
+var PushMaxBackoff time.Duration
+
+// CgroupRoot 는 호스트 cgroup 파일시스템이 마운트된 경로입니다
+var CgroupRoot string
+
+// ProcRoot 는 호스트 proc 파일시스템이 마운트된 경로입니다
+var ProcRoot string
+
+// HostRoot 는 호스트 루트 파일시스템이 마운트된 경로입니다
+// 호스트 경로 앞에 붙여 컨테이너 안에서 접근할 때 사용합니다
+var HostRoot string
+
+// KubeletRoot 는 호스트의 kubelet 루트 디렉터리입니다
+var KubeletRoot string
+
+// KubeletURL 은 파드 이름과 네임스페이스를 조회할 kubelet API 주소입니다
+// 비어 있으면 HOST_IP 의 10250 포트를 사용합니다
+var KubeletURL string
+
+// KubeletTokenFile 은 kubelet API 인증에 사용할 서비스 어카운트 토큰 파일입니다
+var KubeletTokenFile string
+
+// KubeletCAFile 은 kubelet 서빙 인증서를 검증할 CA 파일입니다
+var KubeletCAFile string
+
+// KubeletInsecureTLS 가 true 이면 kubelet 서빙 인증서를 검증하지 않습니다
+// kubelet 이 자체 서명 인증서를 사용하는 클러스터에서 사용합니다
+var KubeletInsecureTLS bool
+
+// KubeletRefreshInterval 은 kubelet 에서 파드 목록을 다시 가져오는 주기입니다
+var KubeletRefreshInterval time.Duration
+
+// CRIEndpoint 는 컨테이너 이름, 이미지 등을 조회할 CRI 런타임 소켓입니다
+// 예: unix:///run/containerd/containerd.sock, unix:///var/run/crio/crio.sock
+// 비어 있으면 CRI 런타임을 조회하지 않습니다
+var CRIEndpoint string
+
+// CRIRefreshInterval 은 CRI 런타임에서 컨테이너 목록을 다시 가져오는 주기입니다
+var CRIRefreshInterval time.Duration
+
+// StorageRefreshInterval 은 파드 emptyDir 볼륨과 쓰기 레이어의 디스크 사용량을 다시 계산하는 주기입니다
+// 디렉터리 전체를 순회해야 하므로 kubelet 과 같이 수집 주기보다 길게 두고 그 사이에는 이전 값을 사용합니다
+var StorageRefreshInterval time.Duration
+
+// PerCPUMetrics 가 false 이면 논리 CPU 별 시간을 수집하지 않습니다
+// 코어가 많은 노드에서 샘플 크기를 줄일 때 사용합니다
+var PerCPUMetrics bool
+
+// FilesystemExcludeMountPatterns 는 노드 파일시스템 수집에서 제외할 마운트 경로 패턴입니다
+var FilesystemExcludeMountPatterns []*regexp.Regexp
+
+var defaultFilesystemExcludeMountPatterns = []string{
+	`^/(dev|proc|sys|run)($|/)`,
+	`^/var/lib/kubelet/pods/`,
+	`^/var/lib/(docker|containerd)/`,
+	`^/snap/`,
+}
+
+// FilesystemExcludeTypePatterns 는 노드 파일시스템 수집에서 제외할 파일시스템 종류 패턴입니다
+var FilesystemExcludeTypePatterns []*regexp.Regexp
+
+var defaultFilesystemExcludeTypePatterns = []string{
+	`^tmpfs$`,
+	`^overlay$`,
+	`^squashfs$`,
+	`^iso9660$`,
+}
+
+// DiskExcludePatterns 는 노드 디스크 수집에서 제외할 블록 장치 이름 패턴입니다
+var DiskExcludePatterns []*regexp.Regexp
+
+var defaultDiskExcludePatterns = []string{
+	`^loop\d*$`,
+	`^ram\d*$`,
+	`^zram\d*$`,
+	`^dm-\d+$`,
+	`^md\d+$`,
+	`^sr\d+$`,
+	`^fd\d+$`,
+	`^nbd\d+$`,
+}
+
+// NetworkIncludePatterns 는 노드 네트워크 수집에 포함할 인터페이스 이름 패턴입니다
+// 비어 있으면 물리 NIC만 수집합니다
+var NetworkIncludePatterns []*regexp.Regexp
+
+// NetworkExcludePatterns 는 노드 네트워크 수집에서 제외할 인터페이스 이름 패턴입니다
+var NetworkExcludePatterns []*regexp.Regexp
+
+var defaultNetworkExcludePatterns = []string{
+	`^lo$`,
+	`^veth`,
+	`^cni`,
+	`^flannel`,
+	`^cali`,
+	`^cilium_`,
+	`^lxc`,
+	`^docker`,
+	`^br-`,
+	`^virbr`,
+	`^vxlan`,
+	`^tunl`,
+	`^kube-`,
+	`^weave`,
+	`^nodelocaldns`,
+}
+
+func init() {
+	CgroupRoot = os.Getenv("CGROUP_ROOT")
+	if CgroupRoot == "" {
+		CgroupRoot = "/sys/fs/cgroup"
+	}
+	ProcRoot = os.Getenv("HOST_PROC")
+	if ProcRoot == "" {
+		ProcRoot = "/proc"
+	}
+	HostRoot = os.Getenv("HOST_ROOT")
+	KubeletRoot = os.Getenv("KUBELET_ROOT")
+	if KubeletRoot == "" {
+		KubeletRoot = "/var/lib/kubelet"
+	}
+	KubeletURL = os.Getenv("KUBELET_URL")
+	if KubeletURL == "" && os.Getenv("HOST_IP") != "" {
+		KubeletURL = "https://" + net.JoinHostPort(os.Getenv("HOST_IP"), "10250")
+	}
+	KubeletTokenFile = os.Getenv("KUBELET_TOKEN_FILE")
+	if KubeletTokenFile == "" {
+		KubeletTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	}
+	KubeletCAFile = os.Getenv("KUBELET_CA_FILE")
+	if KubeletCAFile == "" {
+		KubeletCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	}
+	KubeletInsecureTLS = os.Getenv("KUBELET_INSECURE_TLS") == "true"
+	KubeletRefreshInterval = parseDuration("KUBELET_REFRESH_INTERVAL", 30*time.Second)
+	CRIEndpoint = os.Getenv("CRI_ENDPOINT")
+	CRIRefreshInterval = parseDuration("CRI_REFRESH_INTERVAL", 30*time.Second)
+	StorageRefreshInterval = parseDuration("STORAGE_REFRESH_INTERVAL", time.Minute)
+	PerCPUMetrics = os.Getenv("PER_CPU_METRICS") != "false"
+	SampleInterval = parseDuration("SAMPLE_INTERVAL", 10*time.Second)
+	HistorySize = parseInt("HISTORY_SIZE", 360)
+	PushURL = os.Getenv("PUSH_URL")
+	PushInterval = parseDuration("PUSH_INTERVAL", 10*time.Second)
+	PushQueueSize = parseInt("PUSH_QUEUE_SIZE", 360)
+	PushMaxBackoff = parseDuration("PUSH_MAX_BACKOFF", 5*time.Minute)
+	DiskExcludePatterns = compilePatterns("DISK_EXCLUDE_PATTERNS", defaultDiskExcludePatterns)
+	NetworkIncludePatterns = compilePatterns("NETWORK_INCLUDE_PATTERNS", nil)
+	NetworkExcludePatterns = compilePatterns("NETWORK_EXCLUDE_PATTERNS", defaultNetworkExcludePatterns)
+	FilesystemExcludeMountPatterns = compilePatterns("FILESYSTEM_EXCLUDE_MOUNT_PATTERNS", defaultFilesystemExcludeMountPatterns)
+	FilesystemExcludeTypePatterns = compilePatterns("FILESYSTEM_EXCLUDE_TYPE_PATTERNS", defaultFilesystemExcludeTypePatterns)
+}
+
+// parseDuration 은 기간 환경변수를 읽어 파싱합니다
+// 환경변수가 비어 있으면 기본값을 사용합니다
+func parseDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Fatalf("invalid %s: %q", key, value)
+	}
+	return duration
+}
+
+// parseInt 는 양의 정수 환경변수를 읽어 파싱합니다
+// 환경변수가 비어 있으면 기본값을 사용합니다
+func parseInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Fatalf("invalid %s: %q", key, value)
+	}
+	return n
+}
+
+// compilePatterns 는 쉼표로 구분된 정규식 목록 환경변수를 읽어 컴파일합니다
+// 환경변수가 비어 있으면 기본값을 사용합니다
+func compilePatterns(key string, defaults []string) []*regexp.Regexp {
+	patterns := defaults
+	if value := os.Getenv(key); value != "" {
+		patterns = strings.Split(value, ",")
+	}
+
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		compiled = append(compiled, regexp.MustCompile(pattern))
+	}
+	return compiled
+}
+
+// MatchAny 는 이름이 패턴 중 하나라도 일치하는지 확인합니다
+func MatchAny(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
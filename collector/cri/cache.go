@@ -0,0 +1,33 @@
+package cri
+
+import (
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cache"
+)
+
+// NewContainerCache 는 컨테이너 ID 로 컨테이너 정보를 찾기 위한 CRI 컨테이너 목록 캐시를 생성합니다
+func NewContainerCache(client *Client) *cache.Cache[string, Container] {
+	return cache.New("CRI containers", client.Containers, func(container Container) string { return container.ID })
+}
+
+// defaultCache 는 Init 으로 설정되는 수집기 전역 컨테이너 캐시입니다
+var defaultCache *cache.Cache[string, Container]
+
+// Init 은 CRI 클라이언트와 전역 컨테이너 캐시를 생성하고 주기적 갱신을 시작합니다
+func Init(endpoint string, refreshInterval time.Duration) error {
+	client, err := NewClient(endpoint)
+	if err != nil {
+		return err
+	}
+
+	defaultCache = NewContainerCache(client)
+	go defaultCache.Run(refreshInterval)
+	return nil
+}
+
+// LookupContainer 는 전역 컨테이너 캐시에서 컨테이너 ID 에 해당하는 컨테이너 정보를 찾습니다
+// Init 이 호출되지 않았으면 항상 false 를 반환합니다
+func LookupContainer(id string) (Container, bool) {
+	return defaultCache.Lookup(id)
+}
@@ -0,0 +1,132 @@
+package cri
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// kubelet 이 CRI 컨테이너에 붙이는 레이블과 어노테이션 키입니다
+const (
+	labelPodName           = "io.kubernetes.pod.name"
+	labelPodNamespace      = "io.kubernetes.pod.namespace"
+	labelPodUID            = "io.kubernetes.pod.uid"
+	labelContainerName     = "io.kubernetes.container.name"
+	annotationRestartCount = "io.kubernetes.container.restartCount"
+)
+
+// requestTimeout 은 CRI 런타임 요청의 제한 시간입니다
+const requestTimeout = 5 * time.Second
+
+// Container 는 CRI 런타임에서 조회한 컨테이너 정보입니다
+type Container struct {
+	ID           string
+	Name         string
+	Image        string
+	PodName      string
+	PodNamespace string
+	PodUID       string
+	RestartCount uint64
+}
+
+// Client 는 containerd, CRI-O 등의 CRI 런타임 gRPC 클라이언트입니다
+type Client struct {
+	conn    *grpc.ClientConn
+	runtime runtimeapi.RuntimeServiceClient
+	image   runtimeapi.ImageServiceClient
+	timeout time.Duration
+}
+
+// NewClient 는 unix 소켓의 CRI 런타임에 연결하는 클라이언트를 생성합니다
+// endpoint 는 unix:///path 형식 또는 소켓의 절대 경로입니다
+func NewClient(endpoint string) (*Client, error) {
+	if strings.HasPrefix(endpoint, "/") {
+		endpoint = "unix://" + endpoint
+	}
+	if !strings.HasPrefix(endpoint, "unix://") {
+		return nil, fmt.Errorf("unsupported CRI endpoint %q: only unix sockets are supported", endpoint)
+	}
+
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CRI client: %w", err)
+	}
+
+	return &Client{
+		conn:    conn,
+		runtime: runtimeapi.NewRuntimeServiceClient(conn),
+		image:   runtimeapi.NewImageServiceClient(conn),
+		timeout: requestTimeout,
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Containers 는 런타임의 모든 컨테이너 정보를 조회합니다
+// 컨테이너의 이미지가 이미지 ID 로만 제공되면 이미지 목록에서 태그를 찾아 사용합니다
+func (c *Client) Containers() ([]Container, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	containersResp, err := c.runtime.ListContainers(ctx, &runtimeapi.ListContainersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CRI containers: %w", err)
+	}
+
+	imageNames := make(map[string]string)
+	if imagesResp, err := c.image.ListImages(ctx, &runtimeapi.ListImagesRequest{}); err == nil {
+		for _, image := range imagesResp.Images {
+			if len(image.RepoTags) > 0 {
+				imageNames[image.Id] = image.RepoTags[0]
+			} else if len(image.RepoDigests) > 0 {
+				imageNames[image.Id] = image.RepoDigests[0]
+			}
+		}
+	}
+
+	containers := make([]Container, 0, len(containersResp.Containers))
+	for _, container := range containersResp.Containers {
+		containers = append(containers, newContainer(container, imageNames))
+	}
+	return containers, nil
+}
+
+func newContainer(container *runtimeapi.Container, imageNames map[string]string) Container {
+	c := Container{
+		ID:           container.Id,
+		Name:         container.Labels[labelContainerName],
+		PodName:      container.Labels[labelPodName],
+		PodNamespace: container.Labels[labelPodNamespace],
+		PodUID:       container.Labels[labelPodUID],
+	}
+	if c.Name == "" && container.Metadata != nil {
+		c.Name = container.Metadata.Name
+	}
+
+	if container.Image != nil {
+		c.Image = container.Image.UserSpecifiedImage
+		if c.Image == "" {
+			c.Image = container.Image.Image
+		}
+	}
+	if name, ok := imageNames[c.Image]; ok {
+		c.Image = name
+	}
+
+	// kubelet 은 재시작 횟수를 어노테이션과 메타데이터의 attempt 로 모두 기록합니다
+	if restartCount, err := strconv.ParseUint(container.Annotations[annotationRestartCount], 10, 64); err == nil {
+		c.RestartCount = restartCount
+	} else if container.Metadata != nil {
+		c.RestartCount = uint64(container.Metadata.Attempt)
+	}
+
+	return c
+}
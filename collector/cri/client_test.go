@@ -0,0 +1,150 @@
+package cri
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+var (
+	containerA = strings.Repeat("a", 64)
+	containerB = strings.Repeat("b", 64)
+	imageID    = "sha256:" + strings.Repeat("1", 64)
+)
+
+// fakeRuntimeService 는 ListContainers 만 구현한 CRI 런타임 서비스입니다
+type fakeRuntimeService struct {
+	runtimeapi.UnimplementedRuntimeServiceServer
+	containers []*runtimeapi.Container
+}
+
+func (s *fakeRuntimeService) ListContainers(ctx context.Context, req *runtimeapi.ListContainersRequest) (*runtimeapi.ListContainersResponse, error) {
+	return &runtimeapi.ListContainersResponse{Containers: s.containers}, nil
+}
+
+// fakeImageService 는 ListImages 만 구현한 CRI 이미지 서비스입니다
+type fakeImageService struct {
+	runtimeapi.UnimplementedImageServiceServer
+	images []*runtimeapi.Image
+}
+
+func (s *fakeImageService) ListImages(ctx context.Context, req *runtimeapi.ListImagesRequest) (*runtimeapi.ListImagesResponse, error) {
+	return &runtimeapi.ListImagesResponse{Images: s.images}, nil
+}
+
+// startFakeServer 는 임시 unix 소켓에서 fake CRI 서버를 실행하고 소켓 경로를 반환합니다
+func startFakeServer(t *testing.T, runtime *fakeRuntimeService, image *fakeImageService) string {
+	t.Helper()
+	socket := filepath.Join(t.TempDir(), "cri.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := grpc.NewServer()
+	runtimeapi.RegisterRuntimeServiceServer(server, runtime)
+	runtimeapi.RegisterImageServiceServer(server, image)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return socket
+}
+
+func newTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+	client, err := NewClient(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestContainers(t *testing.T) {
+	runtime := &fakeRuntimeService{
+		containers: []*runtimeapi.Container{
+			{
+				Id:       containerA,
+				Metadata: &runtimeapi.ContainerMetadata{Name: "app", Attempt: 1},
+				Image:    &runtimeapi.ImageSpec{Image: imageID},
+				Labels: map[string]string{
+					labelContainerName: "app",
+					labelPodName:       "web-7d4b9c",
+					labelPodNamespace:  "default",
+					labelPodUID:        "8f0c2a4e-1b3d-4c5e-9f6a-7b8c9d0e1f2a",
+				},
+				Annotations: map[string]string{annotationRestartCount: "3"},
+			},
+			{
+				// kubelet 레이블과 어노테이션이 없는 컨테이너는 메타데이터로 대체합니다
+				Id:       containerB,
+				Metadata: &runtimeapi.ContainerMetadata{Name: "sidecar", Attempt: 2},
+				Image:    &runtimeapi.ImageSpec{Image: "registry.example.com/sidecar:1.0"},
+			},
+		},
+	}
+	image := &fakeImageService{
+		images: []*runtimeapi.Image{
+			{Id: imageID, RepoTags: []string{"docker.io/library/nginx:1.27"}},
+		},
+	}
+
+	client := newTestClient(t, "unix://"+startFakeServer(t, runtime, image))
+	containers, err := client.Containers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Container{
+		{
+			ID:           containerA,
+			Name:         "app",
+			Image:        "docker.io/library/nginx:1.27",
+			PodName:      "web-7d4b9c",
+			PodNamespace: "default",
+			PodUID:       "8f0c2a4e-1b3d-4c5e-9f6a-7b8c9d0e1f2a",
+			RestartCount: 3,
+		},
+		{
+			ID:           containerB,
+			Name:         "sidecar",
+			Image:        "registry.example.com/sidecar:1.0",
+			RestartCount: 2,
+		},
+	}
+	if !reflect.DeepEqual(containers, want) {
+		t.Errorf("Containers() = %+v, want %+v", containers, want)
+	}
+}
+
+func TestContainerCacheLookup(t *testing.T) {
+	runtime := &fakeRuntimeService{
+		containers: []*runtimeapi.Container{
+			{Id: containerA, Metadata: &runtimeapi.ContainerMetadata{Name: "app"}},
+		},
+	}
+
+	// 소켓 경로만 주어도 unix 엔드포인트로 연결합니다
+	cache := NewContainerCache(newTestClient(t, startFakeServer(t, runtime, &fakeImageService{})))
+	cache.Refresh()
+
+	container, ok := cache.Lookup(containerA)
+	if !ok || container.Name != "app" {
+		t.Errorf("Lookup(%s) = %+v, %v, want app", containerA, container, ok)
+	}
+	if _, ok := cache.Lookup(containerB); ok {
+		t.Errorf("Lookup(%s) found unknown container", containerB)
+	}
+}
+
+func TestNewClientRejectsNonUnixEndpoint(t *testing.T) {
+	if _, err := NewClient("tcp://127.0.0.1:1234"); err == nil {
+		t.Error("NewClient() with tcp endpoint succeeded, want error")
+	}
+}
@@ -0,0 +1,176 @@
+package exposition
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type Format int
+
+const (
+	FormatJSON Format = iota
+	FormatPrometheus
+	FormatOpenMetrics
+)
+
+const (
+	ContentTypePrometheus  = "text/plain; version=0.0.4; charset=utf-8"
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// metricPrefix 는 node_exporter, cAdvisor 등의 메트릭과 이름이 겹치지 않도록 모든 메트릭에 붙는 접두사입니다
+const metricPrefix = "collector_"
+
+const (
+	typeGauge   = "gauge"
+	typeCounter = "counter"
+)
+
+// NegotiateFormat 은 ?format= 쿼리 파라미터 또는 Accept 헤더로 응답 형식을 결정합니다
+// 쿼리 파라미터가 Accept 헤더보다 우선하며, 둘 다 없으면 기존 JSON 형식을 사용합니다
+func NegotiateFormat(r *http.Request) Format {
+	switch strings.ToLower(r.URL.Query().Get("format")) {
+	case "prometheus", "text":
+		return FormatPrometheus
+	case "openmetrics":
+		return FormatOpenMetrics
+	case "json":
+		return FormatJSON
+	}
+
+	format := FormatJSON
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "application/openmetrics-text":
+			return FormatOpenMetrics
+		case "text/plain":
+			format = FormatPrometheus
+		}
+	}
+	return format
+}
+
+// ContentType 은 형식에 맞는 Content-Type 헤더 값을 반환합니다
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPrometheus:
+		return ContentTypePrometheus
+	case FormatOpenMetrics:
+		return ContentTypeOpenMetrics
+	default:
+		return "application/json"
+	}
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	labels []label
+	value  float64
+}
+
+type family struct {
+	name    string // counter 는 _total 접미사를 제외한 이름
+	help    string
+	typ     string
+	samples []sample
+}
+
+// families 는 같은 이름의 샘플이 한 곳에 모여 출력되도록 메트릭 패밀리를 등록 순서대로 모읍니다
+type families struct {
+	index map[string]*family
+	order []*family
+}
+
+func newFamilies() *families {
+	return &families{index: make(map[string]*family)}
+}
+
+func (fs *families) gauge(name, help string, value float64, labels ...label) {
+	fs.add(name, help, typeGauge, value, labels)
+}
+
+// counter 의 name 에는 _total 접미사를 붙이지 않습니다
+func (fs *families) counter(name, help string, value float64, labels ...label) {
+	fs.add(name, help, typeCounter, value, labels)
+}
+
+func (fs *families) add(name, help, typ string, value float64, labels []label) {
+	f, ok := fs.index[name]
+	if !ok {
+		f = &family{name: metricPrefix + name, help: help, typ: typ}
+		fs.index[name] = f
+		fs.order = append(fs.order, f)
+	}
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+// write 는 Prometheus 텍스트 형식(0.0.4) 또는 OpenMetrics 1.0 형식으로 메트릭을 출력합니다
+// 두 형식은 counter 의 TYPE 이름과 # EOF 종료 줄만 다릅니다
+func (fs *families) write(w io.Writer, format Format) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range fs.order {
+		sampleName := f.name
+		familyName := f.name
+		if f.typ == typeCounter {
+			sampleName += "_total"
+			if format != FormatOpenMetrics {
+				familyName = sampleName
+			}
+		}
+
+		bw.WriteString("# HELP " + familyName + " " + escapeHelp(f.help) + "\n")
+		bw.WriteString("# TYPE " + familyName + " " + f.typ + "\n")
+		for _, s := range f.samples {
+			bw.WriteString(sampleName)
+			writeLabels(bw, s.labels)
+			bw.WriteString(" " + formatValue(s.value) + "\n")
+		}
+	}
+	if format == FormatOpenMetrics {
+		bw.WriteString("# EOF\n")
+	}
+	return bw.Flush()
+}
+
+func writeLabels(bw *bufio.Writer, labels []label) {
+	if len(labels) == 0 {
+		return
+	}
+	bw.WriteByte('{')
+	for i, l := range labels {
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		bw.WriteString(l.name + `="` + escapeLabelValue(l.value) + `"`)
+	}
+	bw.WriteByte('}')
+}
+
+var (
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelValueReplacer.Replace(s)
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,92 @@
+package exposition
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		accept string
+		want   Format
+	}{
+		{"default", "", "", FormatJSON},
+		{"query prometheus", "?format=prometheus", "", FormatPrometheus},
+		{"query text", "?format=text", "", FormatPrometheus},
+		{"query openmetrics", "?format=OpenMetrics", "", FormatOpenMetrics},
+		{"query json over accept", "?format=json", "application/openmetrics-text; version=1.0.0", FormatJSON},
+		{"query over accept", "?format=prometheus", "application/openmetrics-text; version=1.0.0", FormatPrometheus},
+		{"unknown query falls back to accept", "?format=xml", "text/plain; version=0.0.4", FormatPrometheus},
+		{"accept text", "", "text/plain; version=0.0.4", FormatPrometheus},
+		{"accept openmetrics", "", "application/openmetrics-text; version=1.0.0", FormatOpenMetrics},
+		{"accept openmetrics preferred", "", "text/plain;version=0.0.4;q=0.5, application/openmetrics-text;version=1.0.0", FormatOpenMetrics},
+		{"accept json", "", "application/json", FormatJSON},
+		{"accept invalid", "", ";;", FormatJSON},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/metrics"+tt.query, nil)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+		if got := NegotiateFormat(r); got != tt.want {
+			t.Errorf("%s: NegotiateFormat() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFamiliesWrite(t *testing.T) {
+	fs := newFamilies()
+	fs.counter("node_cpu_seconds", "Total CPU time.", 12.5, label{"node", "node-1"})
+	fs.gauge("pod_info", "Help with \\ and\nnewline.", 1, label{"node", "node-1"}, label{"pod", `a"b\c` + "\nd"})
+	fs.counter("node_cpu_seconds", "Total CPU time.", 3, label{"node", "node-2"})
+	fs.gauge("node_load1", "Load.", 0.25)
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: FormatPrometheus,
+			want: `# HELP collector_node_cpu_seconds_total Total CPU time.
+# TYPE collector_node_cpu_seconds_total counter
+collector_node_cpu_seconds_total{node="node-1"} 12.5
+collector_node_cpu_seconds_total{node="node-2"} 3
+# HELP collector_pod_info Help with \\ and\nnewline.
+# TYPE collector_pod_info gauge
+collector_pod_info{node="node-1",pod="a\"b\\c\nd"} 1
+# HELP collector_node_load1 Load.
+# TYPE collector_node_load1 gauge
+collector_node_load1 0.25
+`,
+		},
+		{
+			format: FormatOpenMetrics,
+			want: `# HELP collector_node_cpu_seconds Total CPU time.
+# TYPE collector_node_cpu_seconds counter
+collector_node_cpu_seconds_total{node="node-1"} 12.5
+collector_node_cpu_seconds_total{node="node-2"} 3
+# HELP collector_pod_info Help with \\ and\nnewline.
+# TYPE collector_pod_info gauge
+collector_pod_info{node="node-1",pod="a\"b\\c\nd"} 1
+# HELP collector_node_load1 Load.
+# TYPE collector_node_load1 gauge
+collector_node_load1 0.25
+# EOF
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		var sb strings.Builder
+		if err := fs.write(&sb, tt.format); err != nil {
+			t.Fatalf("write(%d) error: %v", tt.format, err)
+		}
+		if got := sb.String(); got != tt.want {
+			t.Errorf("write(%d) =\n%s\nwant\n%s", tt.format, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package exposition
+
+import (
+	"io"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+// Write 는 수집한 노드/파드 메트릭을 Prometheus 또는 OpenMetrics 텍스트 형식으로 출력합니다
+// 모든 샘플에 node 레이블이, 파드와 컨테이너 샘플에는 pod_uid 레이블이 붙습니다
+// 수집에 실패한 필드 묶음은 0 대신 출력하지 않아 counter 가 초기화된 것처럼 보이지 않게 합니다
+func Write(w io.Writer, format Format, metric types.Metric) error {
+	fs := newFamilies()
+	addCollectErrors(fs, metric.NodeMetric.NodeName, metric.Errors)
+	addNodeMetric(fs, metric.NodeMetric)
+	for _, podMetric := range metric.PodMetric {
+		addPodMetric(fs, metric.NodeMetric.NodeName, podMetric)
+	}
+	for _, podMetric := range metric.PodMetric {
+		for _, containerMetric := range podMetric.Containers {
+			addContainerMetric(fs, metric.NodeMetric.NodeName, podMetric.UID, containerMetric)
+		}
+	}
+	return fs.write(w, format)
+}
+
+// addCollectErrors 는 하위 시스템별 수집 실패 횟수를 추가합니다
+func addCollectErrors(fs *families, nodeName string, errs []types.CollectError) {
+	counts := make(map[string]int)
+	var subsystems []string
+	for _, err := range errs {
+		if counts[err.Subsystem] == 0 {
+			subsystems = append(subsystems, err.Subsystem)
+		}
+		counts[err.Subsystem]++
+	}
+	for _, subsystem := range subsystems {
+		fs.gauge("collect_errors", "Number of collection failures in the last collection by subsystem.", float64(counts[subsystem]), label{"node", nodeName}, label{"subsystem", subsystem})
+	}
+}
+
+func addNodeMetric(fs *families, n types.NodeMetric) {
+	node := label{"node", n.NodeName}
+
+	if n.IsValid(types.FieldCPU) {
+		fs.counter("node_cpu_seconds", "Total CPU time of the node in seconds.", n.CPUTotal, node)
+		fs.counter("node_cpu_busy_seconds", "CPU time of the node spent outside idle, iowait and steal in seconds.", n.CPUBusy, node)
+		fs.gauge("node_cpu_count", "Number of logical CPUs of the node.", float64(n.CPUCount), node)
+		addCPUModeMetric(fs, "node_cpu_mode_seconds", "CPU time of the node spent in each mode in seconds.", n.CPUModes, node)
+	}
+	if n.IsValid(types.FieldPerCPU) {
+		for _, c := range n.CPUs {
+			addCPUModeMetric(fs, "node_cpu_core_seconds", "CPU time of the logical CPU spent in each mode in seconds.", c.CPUTimes, node, label{"cpu", c.CPU})
+		}
+	}
+
+	if n.IsValid(types.FieldSystem) {
+		fs.gauge("node_boot_info", "Boot ID of the node. Always 1.", 1, node, label{"boot_id", n.BootID})
+		fs.gauge("node_boot_time_seconds", "Node boot time in seconds since the Unix epoch.", float64(n.BootTime), node)
+		fs.gauge("node_uptime_seconds", "Time since the node booted in seconds.", float64(n.Uptime), node)
+		fs.gauge("node_load1", "1 minute load average of the node.", n.Load1, node)
+		fs.gauge("node_load5", "5 minute load average of the node.", n.Load5, node)
+		fs.gauge("node_load15", "15 minute load average of the node.", n.Load15, node)
+		fs.counter("node_context_switches", "Context switches of the node since boot.", float64(n.ContextSwitches), node)
+		fs.gauge("node_procs", "Number of processes on the node.", float64(n.ProcsTotal), node)
+		fs.gauge("node_procs_running", "Number of runnable processes on the node.", float64(n.ProcsRunning), node)
+		fs.gauge("node_procs_blocked", "Number of processes blocked on I/O on the node.", float64(n.ProcsBlocked), node)
+	}
+
+	if n.IsValid(types.FieldMemory) {
+		fs.gauge("node_memory_total_bytes", "Total memory of the node in bytes.", float64(n.MemoryTotal), node)
+		fs.gauge("node_memory_available_bytes", "Available memory of the node in bytes.", float64(n.MemoryAvailable), node)
+		fs.gauge("node_memory_used_bytes", "Used memory of the node in bytes.", float64(n.MemoryUsed), node)
+		fs.gauge("node_memory_buffers_bytes", "Memory used by kernel buffers in bytes.", float64(n.MemoryBuffers), node)
+		fs.gauge("node_memory_cached_bytes", "Memory used by the page cache in bytes.", float64(n.MemoryCached), node)
+		fs.gauge("node_memory_dirty_bytes", "Memory waiting to be written back to disk in bytes.", float64(n.MemoryDirty), node)
+		fs.gauge("node_memory_slab_reclaimable_bytes", "Reclaimable kernel slab memory in bytes.", float64(n.MemorySlabReclaimable), node)
+		fs.gauge("node_memory_slab_unreclaimable_bytes", "Unreclaimable kernel slab memory in bytes.", float64(n.MemorySlabUnreclaimable), node)
+		fs.gauge("node_memory_swap_total_bytes", "Total swap space of the node in bytes.", float64(n.SwapTotal), node)
+		fs.gauge("node_memory_swap_used_bytes", "Used swap space of the node in bytes.", float64(n.SwapUsed), node)
+		fs.gauge("node_memory_hugepages", "Number of huge pages in the pool.", float64(n.HugePagesTotal), node)
+		fs.gauge("node_memory_hugepages_free", "Number of free huge pages in the pool.", float64(n.HugePagesFree), node)
+		fs.gauge("node_memory_hugepage_size_bytes", "Size of a huge page in bytes.", float64(n.HugePageSize), node)
+	}
+
+	if n.IsValid(types.FieldDisk) {
+		fs.counter("node_disk_read_bytes", "Bytes read from the physical disks of the node.", float64(n.DiskReadBytes), node)
+		fs.counter("node_disk_written_bytes", "Bytes written to the physical disks of the node.", float64(n.DiskWriteBytes), node)
+		for _, d := range n.Disks {
+			device := label{"device", d.Device}
+			fs.counter("node_disk_device_read_bytes", "Bytes read from the block device.", float64(d.ReadBytes), node, device)
+			fs.counter("node_disk_device_written_bytes", "Bytes written to the block device.", float64(d.WriteBytes), node, device)
+			fs.counter("node_disk_device_reads_completed", "Reads completed on the block device.", float64(d.ReadCount), node, device)
+			fs.counter("node_disk_device_writes_completed", "Writes completed on the block device.", float64(d.WriteCount), node, device)
+			fs.counter("node_disk_device_reads_merged", "Adjacent reads merged on the block device.", float64(d.MergedReadCount), node, device)
+			fs.counter("node_disk_device_writes_merged", "Adjacent writes merged on the block device.", float64(d.MergedWriteCount), node, device)
+			fs.counter("node_disk_device_read_time_seconds", "Time spent on completed reads on the block device in seconds.", float64(d.ReadTime)/1e3, node, device)
+			fs.counter("node_disk_device_write_time_seconds", "Time spent on completed writes on the block device in seconds.", float64(d.WriteTime)/1e3, node, device)
+			fs.counter("node_disk_device_io_time_seconds", "Time spent doing I/O on the block device in seconds.", float64(d.IoTime)/1e3, node, device)
+			fs.counter("node_disk_device_io_time_weighted_seconds", "Time spent doing I/O on the block device weighted by the number of in-flight requests in seconds.", float64(d.WeightedIoTime)/1e3, node, device)
+		}
+	}
+
+	if n.IsValid(types.FieldNetwork) {
+		fs.counter("node_network_receive_bytes", "Bytes received on the physical interfaces of the node.", float64(n.NetworkRxBytes), node)
+		fs.counter("node_network_transmit_bytes", "Bytes transmitted on the physical interfaces of the node.", float64(n.NetworkTxBytes), node)
+		addInterfaceMetric(fs, "node", n.Interfaces, node)
+	}
+
+	for _, f := range n.Filesystems {
+		labels := []label{node, {"mountpoint", f.Mountpoint}, {"device", f.Device}, {"fstype", f.FsType}}
+		fs.gauge("node_filesystem_size_bytes", "Size of the filesystem in bytes.", float64(f.SizeBytes), labels...)
+		fs.gauge("node_filesystem_used_bytes", "Used space of the filesystem in bytes.", float64(f.UsedBytes), labels...)
+		fs.gauge("node_filesystem_avail_bytes", "Space of the filesystem available to non-root users in bytes.", float64(f.AvailBytes), labels...)
+		fs.gauge("node_filesystem_inodes", "Total inodes of the filesystem.", float64(f.InodesTotal), labels...)
+		fs.gauge("node_filesystem_inodes_used", "Used inodes of the filesystem.", float64(f.InodesUsed), labels...)
+	}
+
+	addPressureMetric(fs, "node", n.Pressure, node)
+}
+
+func addPodMetric(fs *families, nodeName string, p types.PodMetric) {
+	node := label{"node", nodeName}
+	podUID := label{"pod_uid", p.UID}
+
+	if p.QoSClass != "" {
+		fs.gauge("pod_qos_class", "QoS class of the pod derived from its cgroup path. Always 1.", 1, node, podUID, label{"qos_class", p.QoSClass})
+	}
+
+	if p.IsValid(types.FieldCPU) {
+		fs.counter("pod_cpu_usage_seconds", "CPU time consumed by the pod in seconds.", float64(p.CPUUsageUsec)/1e6, node, podUID)
+		fs.counter("pod_cpu_cfs_periods", "Elapsed CFS enforcement periods of the pod.", float64(p.CPUNrPeriods), node, podUID)
+		fs.counter("pod_cpu_cfs_throttled_periods", "CFS periods in which the pod was throttled.", float64(p.CPUNrThrottled), node, podUID)
+		fs.counter("pod_cpu_cfs_throttled_seconds", "Time the pod was throttled by CFS in seconds.", float64(p.CPUThrottledUsec)/1e6, node, podUID)
+	}
+	// 제한이 없는 항목은 노출하지 않습니다
+	if p.IsValid(types.FieldLimits) {
+		fs.gauge("pod_cpu_weight", "Relative CPU weight of the pod derived from its CPU requests.", float64(p.CPUWeight), node, podUID)
+		if p.CPUQuotaUsec > 0 && p.CPUPeriodUsec > 0 {
+			fs.gauge("pod_cpu_limit_cores", "CPU limit of the pod in cores.", float64(p.CPUQuotaUsec)/float64(p.CPUPeriodUsec), node, podUID)
+		}
+		if p.MemoryMax > 0 {
+			fs.gauge("pod_memory_limit_bytes", "Hard memory limit of the pod in bytes.", float64(p.MemoryMax), node, podUID)
+		}
+		if p.MemoryHigh > 0 {
+			fs.gauge("pod_memory_high_bytes", "Memory throttling threshold of the pod in bytes.", float64(p.MemoryHigh), node, podUID)
+		}
+	}
+
+	if p.IsValid(types.FieldMemory) {
+		fs.gauge("pod_memory_usage_bytes", "Memory usage of the pod including page cache in bytes.", float64(p.MemoryUsage), node, podUID)
+		fs.gauge("pod_memory_working_set_bytes", "Working set memory of the pod in bytes.", float64(p.MemoryWorkingSet), node, podUID)
+		fs.gauge("pod_memory_rss_bytes", "Anonymous memory of the pod in bytes.", float64(p.MemoryRSS), node, podUID)
+		fs.gauge("pod_memory_cache_bytes", "Page cache memory of the pod in bytes.", float64(p.MemoryCache), node, podUID)
+		fs.gauge("pod_memory_kernel_bytes", "Kernel memory of the pod in bytes.", float64(p.MemoryKernel), node, podUID)
+		fs.gauge("pod_memory_sock_bytes", "Socket buffer memory of the pod in bytes.", float64(p.MemorySock), node, podUID)
+		fs.gauge("pod_memory_swap_bytes", "Swap usage of the pod in bytes.", float64(p.MemorySwap), node, podUID)
+	}
+	if p.IsValid(types.FieldMemoryEvents) {
+		for _, event := range []struct {
+			name  string
+			value uint64
+		}{
+			{"high", p.MemoryHighEvents},
+			{"max", p.MemoryMaxEvents},
+			{"oom", p.OomEvents},
+			{"oom_kill", p.OomKillEvents},
+		} {
+			fs.counter("pod_memory_events", "Memory events of the pod from memory.events.", float64(event.value), node, podUID, label{"event", event.name})
+		}
+	}
+
+	if p.IsValid(types.FieldDisk) {
+		fs.counter("pod_disk_read_bytes", "Bytes read by the pod.", float64(p.DiskReadBytes), node, podUID)
+		fs.counter("pod_disk_written_bytes", "Bytes written by the pod.", float64(p.DiskWriteBytes), node, podUID)
+		fs.counter("pod_disk_reads_completed", "Reads completed by the pod.", float64(p.DiskReadCount), node, podUID)
+		fs.counter("pod_disk_writes_completed", "Writes completed by the pod.", float64(p.DiskWriteCount), node, podUID)
+		for _, d := range p.Disks {
+			device := label{"device", d.Device}
+			fs.counter("pod_disk_device_read_bytes", "Bytes read by the pod from the block device.", float64(d.ReadBytes), node, podUID, device)
+			fs.counter("pod_disk_device_written_bytes", "Bytes written by the pod to the block device.", float64(d.WriteBytes), node, podUID, device)
+			fs.counter("pod_disk_device_reads_completed", "Reads completed by the pod on the block device.", float64(d.ReadCount), node, podUID, device)
+			fs.counter("pod_disk_device_writes_completed", "Writes completed by the pod on the block device.", float64(d.WriteCount), node, podUID, device)
+		}
+	}
+	// hostNetwork 파드의 네트워크, TCP 메트릭은 노드 전체의 값이므로 파드별로 노출하지 않습니다
+	if p.HostNetwork {
+		fs.gauge("pod_host_network", "Whether the pod uses the host network namespace. Always 1.", 1, node, podUID)
+	}
+	if p.IsValid(types.FieldNetwork) && !p.HostNetwork {
+		fs.counter("pod_network_receive_bytes", "Bytes received by the pod excluding loopback.", float64(p.NetworkRxBytes), node, podUID)
+		fs.counter("pod_network_transmit_bytes", "Bytes transmitted by the pod excluding loopback.", float64(p.NetworkTxBytes), node, podUID)
+		addInterfaceMetric(fs, "pod", p.Interfaces, node, podUID)
+	}
+	if p.IsValid(types.FieldTCP) && !p.HostNetwork {
+		for _, state := range []struct {
+			name  string
+			value uint64
+		}{
+			{"established", p.TCP.Established},
+			{"syn_sent", p.TCP.SynSent},
+			{"syn_recv", p.TCP.SynRecv},
+			{"fin_wait", p.TCP.FinWait},
+			{"time_wait", p.TCP.TimeWait},
+			{"close_wait", p.TCP.CloseWait},
+			{"last_ack", p.TCP.LastAck},
+			{"listen", p.TCP.Listen},
+		} {
+			fs.gauge("pod_tcp_connections", "Number of TCP sockets of the pod by state.", float64(state.value), node, podUID, label{"state", state.name})
+		}
+		fs.counter("pod_tcp_sent_segments", "TCP segments sent by the pod.", float64(p.TCP.OutSegs), node, podUID)
+		fs.counter("pod_tcp_retransmitted_segments", "TCP segments retransmitted by the pod.", float64(p.TCP.RetransSegs), node, podUID)
+		fs.counter("pod_tcp_listen_overflows", "Times the accept queue of a listening socket of the pod overflowed.", float64(p.TCP.ListenOverflows), node, podUID)
+		fs.counter("pod_tcp_listen_drops", "Connection requests dropped by listening sockets of the pod.", float64(p.TCP.ListenDrops), node, podUID)
+	}
+
+	if p.IsValid(types.FieldStorage) {
+		fs.gauge("pod_ephemeral_storage_bytes", "Ephemeral storage used by the pod on the node disk in bytes.", float64(p.EphemeralEmptyDirBytes), node, podUID, label{"source", "empty_dir"})
+		fs.gauge("pod_ephemeral_storage_bytes", "Ephemeral storage used by the pod on the node disk in bytes.", float64(p.EphemeralWritableLayerBytes), node, podUID, label{"source", "writable_layer"})
+	}
+
+	if p.IsValid(types.FieldPids) {
+		fs.gauge("pod_pids", "Number of processes and threads in the pod cgroup.", float64(p.PidsCurrent), node, podUID)
+		if p.PidsMax > 0 {
+			fs.gauge("pod_pids_limit", "Maximum number of processes and threads allowed in the pod cgroup.", float64(p.PidsMax), node, podUID)
+		}
+	}
+	if p.IsValid(types.FieldFds) {
+		fs.gauge("pod_open_fds", "Number of file descriptors opened by processes of the pod.", float64(p.OpenFds), node, podUID)
+	}
+
+	addPressureMetric(fs, "pod", p.Pressure, node, podUID)
+}
+
+func addContainerMetric(fs *families, nodeName, podUID string, c types.ContainerMetric) {
+	labels := []label{{"node", nodeName}, {"pod_uid", podUID}, {"container_id", c.ID}}
+
+	if c.Name != "" {
+		fs.gauge("container_info", "Container metadata from the CRI runtime. Always 1.", 1, append(labels, label{"container", c.Name}, label{"image", c.Image})...)
+		// 재시작하면 컨테이너 ID 가 바뀌므로 파드 UID 와 컨테이너 이름을 레이블로 사용하는 gauge 로 노출합니다
+		fs.gauge("container_restarts", "Number of times the container has been restarted.", float64(c.RestartCount), label{"node", nodeName}, label{"pod_uid", podUID}, label{"container", c.Name})
+	}
+
+	fs.counter("container_cpu_usage_seconds", "CPU time consumed by the container in seconds.", float64(c.CPUUsageUsec)/1e6, labels...)
+	fs.counter("container_cpu_cfs_periods", "Elapsed CFS enforcement periods of the container.", float64(c.CPUNrPeriods), labels...)
+	fs.counter("container_cpu_cfs_throttled_periods", "CFS periods in which the container was throttled.", float64(c.CPUNrThrottled), labels...)
+	fs.counter("container_cpu_cfs_throttled_seconds", "Time the container was throttled by CFS in seconds.", float64(c.CPUThrottledUsec)/1e6, labels...)
+	fs.gauge("container_memory_usage_bytes", "Memory usage of the container in bytes.", float64(c.MemoryUsage), labels...)
+	fs.counter("container_disk_read_bytes", "Bytes read by the container.", float64(c.DiskReadBytes), labels...)
+	fs.counter("container_disk_written_bytes", "Bytes written by the container.", float64(c.DiskWriteBytes), labels...)
+}
+
+// addCPUModeMetric 은 CPU 모드별 누적 시간을 mode 레이블과 함께 추가합니다
+// guest, guest_nice 는 user, nice 에 포함되어 있으므로 mode 별 값을 합산하면 안 됩니다
+func addCPUModeMetric(fs *families, name, help string, t types.CPUTimes, labels ...label) {
+	for _, mode := range []struct {
+		name  string
+		value float64
+	}{
+		{"user", t.User},
+		{"nice", t.Nice},
+		{"system", t.System},
+		{"idle", t.Idle},
+		{"iowait", t.Iowait},
+		{"irq", t.Irq},
+		{"softirq", t.Softirq},
+		{"steal", t.Steal},
+		{"guest", t.Guest},
+		{"guest_nice", t.GuestNice},
+	} {
+		fs.counter(name, help, mode.value, append(labels, label{"mode", mode.name})...)
+	}
+}
+
+// addInterfaceMetric 은 네트워크 인터페이스별 카운터를 interface 레이블과 함께 추가합니다
+func addInterfaceMetric(fs *families, scope string, interfaces []types.InterfaceMetric, labels ...label) {
+	for _, i := range interfaces {
+		l := append(append([]label{}, labels...), label{"interface", i.Name})
+		fs.counter(scope+"_network_interface_receive_bytes", "Bytes received on the interface.", float64(i.RxBytes), l...)
+		fs.counter(scope+"_network_interface_transmit_bytes", "Bytes transmitted on the interface.", float64(i.TxBytes), l...)
+		fs.counter(scope+"_network_interface_receive_packets", "Packets received on the interface.", float64(i.RxPackets), l...)
+		fs.counter(scope+"_network_interface_transmit_packets", "Packets transmitted on the interface.", float64(i.TxPackets), l...)
+		fs.counter(scope+"_network_interface_receive_errors", "Receive errors on the interface.", float64(i.RxErrors), l...)
+		fs.counter(scope+"_network_interface_transmit_errors", "Transmit errors on the interface.", float64(i.TxErrors), l...)
+		fs.counter(scope+"_network_interface_receive_dropped", "Received packets dropped on the interface.", float64(i.RxDropped), l...)
+		fs.counter(scope+"_network_interface_transmit_dropped", "Transmitted packets dropped on the interface.", float64(i.TxDropped), l...)
+	}
+}
+
+// addPressureMetric 은 PSI 값을 some/full 구분 레이블과 함께 추가합니다
+// avg 값은 백분율이 아닌 0~1 비율로 변환합니다
+func addPressureMetric(fs *families, scope string, pressures []types.PressureMetric, labels ...label) {
+	for _, p := range pressures {
+		for _, kind := range []struct {
+			name                 string
+			avg10, avg60, avg300 float64
+			totalUsec            uint64
+		}{
+			{"some", p.SomeAvg10, p.SomeAvg60, p.SomeAvg300, p.SomeTotal},
+			{"full", p.FullAvg10, p.FullAvg60, p.FullAvg300, p.FullTotal},
+		} {
+			l := append(append([]label{}, labels...), label{"resource", p.Resource}, label{"kind", kind.name})
+			fs.counter(scope+"_pressure_stalled_seconds", "Time tasks were stalled waiting for the resource in seconds.", float64(kind.totalUsec)/1e6, l...)
+			fs.gauge(scope+"_pressure_avg10_ratio", "Share of time stalled over the last 10 seconds.", kind.avg10/100, l...)
+			fs.gauge(scope+"_pressure_avg60_ratio", "Share of time stalled over the last 60 seconds.", kind.avg60/100, l...)
+			fs.gauge(scope+"_pressure_avg300_ratio", "Share of time stalled over the last 300 seconds.", kind.avg300/100, l...)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+// Buffer 는 최근 샘플을 고정된 개수만큼 보관하는 링 버퍼입니다
+// 가득 차면 가장 오래된 샘플부터 덮어씁니다
+type Buffer struct {
+	mu      sync.RWMutex
+	samples []types.Metric
+	next    int // 다음 샘플을 기록할 위치
+	count   int
+}
+
+func NewBuffer(size int) *Buffer {
+	return &Buffer{
+		samples: make([]types.Metric, size),
+	}
+}
+
+// Add 는 샘플을 버퍼에 추가합니다
+func (b *Buffer) Add(metric types.Metric) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.samples[b.next] = metric
+	b.next = (b.next + 1) % len(b.samples)
+	if b.count < len(b.samples) {
+		b.count++
+	}
+}
+
+// Since 는 since 이후의 샘플을 오래된 순서로 반환합니다
+func (b *Buffer) Since(since time.Time) []types.Metric {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	metrics := []types.Metric{}
+	start := (b.next - b.count + len(b.samples)) % len(b.samples)
+	for i := 0; i < b.count; i++ {
+		metric := b.samples[(start+i)%len(b.samples)]
+		if metric.Timestamp.After(since) {
+			metrics = append(metrics, metric)
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,33 @@
+package kubelet
+
+import (
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cache"
+)
+
+// NewPodCache 는 파드 UID 로 이름과 네임스페이스를 찾기 위한 kubelet 파드 목록 캐시를 생성합니다
+func NewPodCache(client *Client) *cache.Cache[string, Pod] {
+	return cache.New("kubelet pods", client.Pods, func(pod Pod) string { return pod.UID })
+}
+
+// defaultCache 는 Init 으로 설정되는 수집기 전역 파드 캐시입니다
+var defaultCache *cache.Cache[string, Pod]
+
+// Init 은 kubelet 클라이언트와 전역 파드 캐시를 생성하고 주기적 갱신을 시작합니다
+func Init(url, tokenFile, caFile string, insecure bool, refreshInterval time.Duration) error {
+	client, err := NewClient(url, tokenFile, caFile, insecure)
+	if err != nil {
+		return err
+	}
+
+	defaultCache = NewPodCache(client)
+	go defaultCache.Run(refreshInterval)
+	return nil
+}
+
+// LookupPod 은 전역 파드 캐시에서 파드 UID 에 해당하는 파드 정보를 찾습니다
+// Init 이 호출되지 않았으면 항상 false 를 반환합니다
+func LookupPod(uid string) (Pod, bool) {
+	return defaultCache.Lookup(uid)
+}
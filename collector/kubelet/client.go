@@ -0,0 +1,99 @@
+package kubelet
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Pod 은 kubelet /pods 응답에서 수집기가 사용하는 파드 정보입니다
+type Pod struct {
+	UID       string
+	Name      string
+	Namespace string
+}
+
+// podList 는 kubelet /pods 응답(v1.PodList)에서 필요한 필드만 정의한 구조체입니다
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			UID       string `json:"uid"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+// Client 는 노드 로컬 kubelet API 클라이언트입니다
+type Client struct {
+	url        string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+func NewClient(url, tokenFile, caFile string, insecure bool) (*Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	if !insecure && caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubelet CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in kubelet CA file %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &Client{
+		url:       strings.TrimSuffix(url, "/"),
+		tokenFile: tokenFile,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// Pods 는 kubelet 이 관리하는 파드 목록을 조회합니다
+func (c *Client) Pods() ([]Pod, error) {
+	req, err := http.NewRequest(http.MethodGet, c.url+"/pods", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 서비스 어카운트 토큰은 주기적으로 갱신되므로 요청마다 다시 읽습니다
+	if token, err := os.ReadFile(c.tokenFile); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from kubelet", resp.StatusCode)
+	}
+
+	var list podList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode kubelet pods: %w", err)
+	}
+
+	pods := make([]Pod, 0, len(list.Items))
+	for _, item := range list.Items {
+		pods = append(pods, Pod{
+			UID:       item.Metadata.UID,
+			Name:      item.Metadata.Name,
+			Namespace: item.Metadata.Namespace,
+		})
+	}
+	return pods, nil
+}
@@ -8,46 +8,131 @@ import (
 
 	"log/slog"
 
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cri"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/exposition"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/history"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/kubelet"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/node"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/pod"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/push"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 )
 
-type Metric struct {
-	Timestamp  time.Time         `json:"timestamp"`
-	NodeMetric types.NodeMetric  `json:"nodeMetric"`
-	PodMetric  []types.PodMetric `json:"podMetric"`
-}
+var buffer *history.Buffer
+
+// pusher 는 push 모드에서만 설정됩니다
+var pusher *push.Pusher
 
 func main() {
 	slog.SetDefault(slog.New(slog.NewJSONHandler(log.Writer(), nil)))
+
+	if config.KubeletURL != "" {
+		err := kubelet.Init(config.KubeletURL, config.KubeletTokenFile, config.KubeletCAFile, config.KubeletInsecureTLS, config.KubeletRefreshInterval)
+		if err != nil {
+			log.Fatal("failed to initialize kubelet client: ", err)
+		}
+	} else {
+		slog.Warn("KUBELET_URL and HOST_IP are not set, pod names and namespaces will not be resolved")
+	}
+
+	if config.CRIEndpoint != "" {
+		if err := cri.Init(config.CRIEndpoint, config.CRIRefreshInterval); err != nil {
+			log.Fatal("failed to initialize CRI client: ", err)
+		}
+	}
+
+	buffer = history.NewBuffer(config.HistorySize)
+	if config.PushURL != "" {
+		pusher = push.NewPusher(config.PushURL, config.PushInterval, config.PushQueueSize, config.PushMaxBackoff)
+		go pusher.Run()
+		slog.Info("push mode enabled", "url", config.PushURL)
+	}
+	go sample()
+
 	http.HandleFunc("/metrics", loggingMiddleware(collect))
+	http.HandleFunc("/metrics/history", loggingMiddleware(collectHistory))
 	http.ListenAndServe(":9000", nil)
 }
 
-func collect(w http.ResponseWriter, r *http.Request) {
-	nodeMetric, err := node.CollectNodeMetric()
-	if err != nil {
-		nodeMetric = types.NodeMetric{}
+// sample 은 SampleInterval 경계에 맞춰 주기적으로 메트릭을 수집하여 버퍼에 저장합니다
+func sample() {
+	interval := config.SampleInterval
+	time.Sleep(time.Until(time.Now().Truncate(interval).Add(interval)))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	record(collectMetric(time.Now().Round(interval)))
+	for t := range ticker.C {
+		record(collectMetric(t.Round(interval)))
 	}
+}
+
+// record 는 샘플을 버퍼에 저장하고, push 모드이면 전송 대기 큐에도 추가합니다
+func record(metric types.Metric) {
+	buffer.Add(metric)
+	if pusher != nil {
+		pusher.Enqueue(metric)
+	}
+}
 
-	podMetrics, err := pod.CollectPodMetrics()
-	if err != nil {
+func collectMetric(timestamp time.Time) types.Metric {
+	nodeMetric, nodeErrs := node.CollectNodeMetric()
+	podMetrics, podErrs := pod.CollectPodMetrics()
+	if podMetrics == nil {
 		podMetrics = []types.PodMetric{}
 	}
 
-	metric := Metric{
-		Timestamp:  time.Now().Truncate(time.Minute),
+	errs := append(nodeErrs, podErrs...)
+	for _, err := range errs {
+		slog.Warn("partial collection failure", "subsystem", err.Subsystem, "podUid", err.PodUID, "containerId", err.ContainerID, "error", err.Message)
+	}
+
+	return types.Metric{
+		Timestamp:  timestamp,
 		NodeMetric: nodeMetric,
 		PodMetric:  podMetrics,
+		Errors:     errs,
+	}
+}
+
+func collect(w http.ResponseWriter, r *http.Request) {
+	metric := collectMetric(time.Now().Truncate(config.SampleInterval))
+
+	format := exposition.NegotiateFormat(r)
+	w.Header().Set("Content-Type", format.ContentType())
+	if format != exposition.FormatJSON {
+		if err := exposition.Write(w, format, metric); err != nil {
+			slog.Error("failed to write metrics", "format", format.ContentType(), "error", err)
+		}
+		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(metric); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
+// collectHistory 는 since 이후에 버퍼에 저장된 샘플을 오래된 순서로 반환합니다
+// since 가 없으면 버퍼의 모든 샘플을 반환합니다
+func collectHistory(w http.ResponseWriter, r *http.Request) {
+	var since time.Time
+	if value := r.URL.Query().Get("since"); value != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			http.Error(w, "invalid since parameter: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(buffer.Since(since)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func loggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -2,14 +2,17 @@ package node
 
 import (
 	"encoding/json"
+	"errors"
 
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 	"github.com/shirou/gopsutil/v4/cpu"
 )
 
 type NodeCpuMetric struct {
-	Total float64 `json:"total"`
-	Busy  float64 `json:"busy"`
-	Count int     `json:"count"`
+	Total float64        `json:"total"`
+	Busy  float64        `json:"busy"`
+	Count int            `json:"count"`
+	Modes types.CPUTimes `json:"modes"`
 }
 
 func (c NodeCpuMetric) String() string {
@@ -17,23 +20,18 @@ func (c NodeCpuMetric) String() string {
 	return string(s)
 }
 
+// CollectNodeCpuMetric 은 노드 전체 CPU 의 모드별 누적 시간을 수집합니다
+// 전체 시간에는 steal 을 포함하고 사용 시간에서는 제외하여, 하이퍼바이저가 가져간 시간만큼 사용률이 부풀려지지 않도록 합니다
 func CollectNodeCpuMetric() (NodeCpuMetric, error) {
 	cpuTimes, err := cpu.Times(false)
 	if err != nil {
 		return NodeCpuMetric{}, err
 	}
+	if len(cpuTimes) == 0 {
+		return NodeCpuMetric{}, errors.New("no cpu times found")
+	}
 
-	cpuTime := cpuTimes[0]
-
-	total := cpuTime.User +
-		cpuTime.System +
-		cpuTime.Idle +
-		cpuTime.Nice +
-		cpuTime.Iowait +
-		cpuTime.Irq +
-		cpuTime.Softirq
-
-	busy := total - cpuTime.Idle - cpuTime.Iowait
+	modes := newCPUTimes(cpuTimes[0])
 
 	cpuCount, err := cpu.Counts(true)
 	if err != nil {
@@ -41,8 +39,41 @@ func CollectNodeCpuMetric() (NodeCpuMetric, error) {
 	}
 
 	return NodeCpuMetric{
-		Total: total,
-		Busy:  busy,
+		Total: modes.Total(),
+		Busy:  modes.Busy(),
 		Count: cpuCount,
+		Modes: modes,
 	}, nil
 }
+
+// CollectNodePerCpuMetric 은 논리 CPU 별 모드별 누적 시간을 수집합니다
+func CollectNodePerCpuMetric() ([]types.CPUCoreMetric, error) {
+	cpuTimes, err := cpu.Times(true)
+	if err != nil {
+		return nil, err
+	}
+
+	cores := make([]types.CPUCoreMetric, 0, len(cpuTimes))
+	for _, cpuTime := range cpuTimes {
+		cores = append(cores, types.CPUCoreMetric{
+			CPU:      cpuTime.CPU,
+			CPUTimes: newCPUTimes(cpuTime),
+		})
+	}
+	return cores, nil
+}
+
+func newCPUTimes(t cpu.TimesStat) types.CPUTimes {
+	return types.CPUTimes{
+		User:      t.User,
+		Nice:      t.Nice,
+		System:    t.System,
+		Idle:      t.Idle,
+		Iowait:    t.Iowait,
+		Irq:       t.Irq,
+		Softirq:   t.Softirq,
+		Steal:     t.Steal,
+		Guest:     t.Guest,
+		GuestNice: t.GuestNice,
+	}
+}
@@ -2,13 +2,19 @@ package node
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
 
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 	"github.com/shirou/gopsutil/v4/disk"
 )
 
 type NodeDiskMetric struct {
-	ReadBytes  uint64 `json:"readBytes"`
-	WriteBytes uint64 `json:"writeBytes"`
+	ReadBytes  uint64             `json:"readBytes"`
+	WriteBytes uint64             `json:"writeBytes"`
+	Devices    []types.DiskMetric `json:"devices"`
 }
 
 func (d NodeDiskMetric) String() string {
@@ -16,16 +22,53 @@ func (d NodeDiskMetric) String() string {
 	return string(s)
 }
 
+// CollectNodeDiskMetric 은 노드의 물리 블록 장치별 디스크 메트릭과 그 합계를 수집합니다
 func CollectNodeDiskMetric() (NodeDiskMetric, error) {
-	diskIOCounters, err := disk.IOCounters("vda")
+	diskIOCounters, err := disk.IOCounters()
 	if err != nil {
 		return NodeDiskMetric{}, err
 	}
 
-	stat := diskIOCounters["vda"]
+	diskMetric := NodeDiskMetric{
+		Devices: []types.DiskMetric{},
+	}
+	for name, stat := range diskIOCounters {
+		if !isPhysicalDisk(name) {
+			continue
+		}
+
+		diskMetric.ReadBytes += stat.ReadBytes
+		diskMetric.WriteBytes += stat.WriteBytes
+		diskMetric.Devices = append(diskMetric.Devices, types.DiskMetric{
+			Device:           name,
+			ReadBytes:        stat.ReadBytes,
+			WriteBytes:       stat.WriteBytes,
+			ReadCount:        stat.ReadCount,
+			WriteCount:       stat.WriteCount,
+			MergedReadCount:  stat.MergedReadCount,
+			MergedWriteCount: stat.MergedWriteCount,
+			ReadTime:         stat.ReadTime,
+			WriteTime:        stat.WriteTime,
+			IoTime:           stat.IoTime,
+			WeightedIoTime:   stat.WeightedIO,
+		})
+	}
+
+	sort.Slice(diskMetric.Devices, func(i, j int) bool {
+		return diskMetric.Devices[i].Device < diskMetric.Devices[j].Device
+	})
 
-	return NodeDiskMetric{
-		ReadBytes:  stat.ReadBytes,
-		WriteBytes: stat.WriteBytes,
-	}, nil
+	return diskMetric, nil
+}
+
+// isPhysicalDisk 는 장치가 제외 패턴에 해당하지 않는 디스크 전체 장치인지 확인합니다
+// 파티션은 /sys/block 아래에 나타나지 않으므로 디스크 전체와 중복 집계되지 않도록 제외합니다
+func isPhysicalDisk(name string) bool {
+	if config.MatchAny(config.DiskExcludePatterns, name) {
+		return false
+	}
+	if _, err := os.Stat(filepath.Join("/sys/block", name)); err != nil {
+		return false
+	}
+	return true
 }
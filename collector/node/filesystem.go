@@ -0,0 +1,64 @@
+package node
+
+import (
+	"log"
+	"path/filepath"
+	"sort"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+// CollectNodeFilesystemMetric 은 호스트에 마운트된 파일시스템별 용량과 inode 사용량을 수집합니다
+// 같은 장치가 여러 경로에 마운트된 경우(bind mount) 가장 짧은 마운트 경로 하나만 수집합니다
+func CollectNodeFilesystemMetric() ([]types.FilesystemMetric, error) {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return len(partitions[i].Mountpoint) < len(partitions[j].Mountpoint)
+	})
+
+	seenDevices := make(map[string]bool)
+	var filesystems []types.FilesystemMetric
+	for _, partition := range partitions {
+		if !isSelectedFilesystem(partition) || seenDevices[partition.Device] {
+			continue
+		}
+
+		usage, err := disk.Usage(filepath.Join(config.HostRoot, partition.Mountpoint))
+		if err != nil {
+			log.Printf("failed to get filesystem usage for %s: %v", partition.Mountpoint, err)
+			continue
+		}
+		seenDevices[partition.Device] = true
+
+		filesystems = append(filesystems, types.FilesystemMetric{
+			Mountpoint:  partition.Mountpoint,
+			Device:      partition.Device,
+			FsType:      partition.Fstype,
+			SizeBytes:   usage.Total,
+			UsedBytes:   usage.Used,
+			AvailBytes:  usage.Free,
+			InodesTotal: usage.InodesTotal,
+			InodesUsed:  usage.InodesUsed,
+		})
+	}
+
+	sort.Slice(filesystems, func(i, j int) bool {
+		return filesystems[i].Mountpoint < filesystems[j].Mountpoint
+	})
+
+	return filesystems, nil
+}
+
+// isSelectedFilesystem 은 마운트 경로와 파일시스템 종류가 제외 패턴에 해당하지 않는지 확인합니다
+func isSelectedFilesystem(partition disk.PartitionStat) bool {
+	if config.MatchAny(config.FilesystemExcludeMountPatterns, partition.Mountpoint) {
+		return false
+	}
+	return !config.MatchAny(config.FilesystemExcludeTypePatterns, partition.Fstype)
+}
@@ -10,6 +10,17 @@ type NodeMemoryMetric struct {
 	Total     uint64 `json:"total"`
 	Available uint64 `json:"available"`
 	Used      uint64 `json:"used"`
+
+	Buffers           uint64 `json:"buffers"`
+	Cached            uint64 `json:"cached"`
+	Dirty             uint64 `json:"dirty"`
+	SlabReclaimable   uint64 `json:"slabReclaimable"`
+	SlabUnreclaimable uint64 `json:"slabUnreclaimable"`
+	SwapTotal         uint64 `json:"swapTotal"`
+	SwapUsed          uint64 `json:"swapUsed"`
+	HugePagesTotal    uint64 `json:"hugePagesTotal"`
+	HugePagesFree     uint64 `json:"hugePagesFree"`
+	HugePageSize      uint64 `json:"hugePageSize"`
 }
 
 func (m NodeMemoryMetric) String() string {
@@ -17,14 +28,38 @@ func (m NodeMemoryMetric) String() string {
 	return string(s)
 }
 
+// CollectNodeMemoryMetric 은 /proc/meminfo 에서 노드 메모리 메트릭을 수집합니다
 func CollectNodeMemoryMetric() (NodeMemoryMetric, error) {
 	virtualMemory, err := mem.VirtualMemory()
 	if err != nil {
 		return NodeMemoryMetric{}, err
 	}
+
+	swapUsed := uint64(0)
+	if virtualMemory.SwapTotal > virtualMemory.SwapFree {
+		swapUsed = virtualMemory.SwapTotal - virtualMemory.SwapFree
+	}
+
+	// gopsutil 의 Cached 는 /proc/meminfo 의 Cached 에 SReclaimable 을 더한 값이므로
+	// SlabReclaimable 과 합산할 수 있도록 페이지 캐시만 저장합니다
+	cached := uint64(0)
+	if virtualMemory.Cached > virtualMemory.Sreclaimable {
+		cached = virtualMemory.Cached - virtualMemory.Sreclaimable
+	}
+
 	return NodeMemoryMetric{
-		Total:     virtualMemory.Total,
-		Available: virtualMemory.Available,
-		Used:      virtualMemory.Used,
+		Total:             virtualMemory.Total,
+		Available:         virtualMemory.Available,
+		Used:              virtualMemory.Used,
+		Buffers:           virtualMemory.Buffers,
+		Cached:            cached,
+		Dirty:             virtualMemory.Dirty,
+		SlabReclaimable:   virtualMemory.Sreclaimable,
+		SlabUnreclaimable: virtualMemory.Sunreclaim,
+		SwapTotal:         virtualMemory.SwapTotal,
+		SwapUsed:          swapUsed,
+		HugePagesTotal:    virtualMemory.HugePagesTotal,
+		HugePagesFree:     virtualMemory.HugePagesFree,
+		HugePageSize:      virtualMemory.HugePageSize,
 	}, nil
 }
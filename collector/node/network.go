@@ -2,13 +2,19 @@ package node
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
 
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 	"github.com/shirou/gopsutil/v4/net"
 )
 
 type NodeNetworkMetric struct {
-	RxBytes uint64 `json:"rxBytes"`
-	TxBytes uint64 `json:"txBytes"`
+	RxBytes    uint64                  `json:"rxBytes"`
+	TxBytes    uint64                  `json:"txBytes"`
+	Interfaces []types.InterfaceMetric `json:"interfaces"`
 }
 
 func (n NodeNetworkMetric) String() string {
@@ -16,18 +22,59 @@ func (n NodeNetworkMetric) String() string {
 	return string(s)
 }
 
+// CollectNodeNetworkMetric 은 선택된 인터페이스별 네트워크 메트릭과 그 합계를 수집합니다
+// 가상 인터페이스(lo, veth, cni 등)를 합산하면 파드 트래픽이 중복 집계되므로 제외합니다
 func CollectNodeNetworkMetric() (NodeNetworkMetric, error) {
-	netIOCounters, err := net.IOCounters(false)
+	netIOCounters, err := net.IOCounters(true)
 	if err != nil {
 		return NodeNetworkMetric{}, err
 	}
 
-	netIOCounter := netIOCounters[0]
-
 	networkMetric := NodeNetworkMetric{
-		RxBytes: netIOCounter.BytesRecv,
-		TxBytes: netIOCounter.BytesSent,
+		Interfaces: []types.InterfaceMetric{},
+	}
+	for _, netIOCounter := range netIOCounters {
+		if !isSelectedInterface(netIOCounter.Name) {
+			continue
+		}
+
+		networkMetric.RxBytes += netIOCounter.BytesRecv
+		networkMetric.TxBytes += netIOCounter.BytesSent
+		networkMetric.Interfaces = append(networkMetric.Interfaces, toInterfaceMetric(netIOCounter))
 	}
 
+	sort.Slice(networkMetric.Interfaces, func(i, j int) bool {
+		return networkMetric.Interfaces[i].Name < networkMetric.Interfaces[j].Name
+	})
+
 	return networkMetric, nil
 }
+
+// isSelectedInterface 는 인터페이스가 수집 대상인지 확인합니다
+// 포함 패턴이 지정되지 않았으면 물리 NIC(/sys/class/net/<name>/device 존재)만 선택합니다
+func isSelectedInterface(name string) bool {
+	if config.MatchAny(config.NetworkExcludePatterns, name) {
+		return false
+	}
+	if len(config.NetworkIncludePatterns) > 0 {
+		return config.MatchAny(config.NetworkIncludePatterns, name)
+	}
+	if _, err := os.Stat(filepath.Join("/sys/class/net", name, "device")); err != nil {
+		return false
+	}
+	return true
+}
+
+func toInterfaceMetric(counter net.IOCountersStat) types.InterfaceMetric {
+	return types.InterfaceMetric{
+		Name:      counter.Name,
+		RxBytes:   counter.BytesRecv,
+		TxBytes:   counter.BytesSent,
+		RxPackets: counter.PacketsRecv,
+		TxPackets: counter.PacketsSent,
+		RxErrors:  counter.Errin,
+		TxErrors:  counter.Errout,
+		RxDropped: counter.Dropin,
+		TxDropped: counter.Dropout,
+	}
+}
@@ -1,48 +1,108 @@
 package node
 
 import (
-	"fmt"
-
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/metadata"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 )
 
-func CollectNodeMetric() (types.NodeMetric, error) {
+// CollectNodeMetric 은 노드 메트릭을 수집합니다
+// 일부 하위 시스템 수집에 실패해도 나머지는 수집하며, 실패한 필드 묶음은 Invalid 와 에러 목록에 기록합니다
+func CollectNodeMetric() (types.NodeMetric, []types.CollectError) {
+	nodeMetric := types.NodeMetric{
+		NodeName: metadata.NodeName,
+	}
+	var errs []types.CollectError
+	fail := func(field string, err error) {
+		nodeMetric.Invalid = append(nodeMetric.Invalid, field)
+		errs = append(errs, types.CollectError{Subsystem: "node." + field, Message: err.Error()})
+	}
+
 	// CPU Metric
-	cpuMetric, err := CollectNodeCpuMetric()
-	if err != nil {
-		return types.NodeMetric{}, fmt.Errorf("failed to collect node CPU metric: %w", err)
+	if cpuMetric, err := CollectNodeCpuMetric(); err != nil {
+		fail(types.FieldCPU, err)
+	} else {
+		nodeMetric.CPUTotal = cpuMetric.Total
+		nodeMetric.CPUBusy = cpuMetric.Busy
+		nodeMetric.CPUCount = cpuMetric.Count
+		nodeMetric.CPUModes = cpuMetric.Modes
+	}
+
+	// Per-CPU Metric
+	if config.PerCPUMetrics {
+		if cores, err := CollectNodePerCpuMetric(); err != nil {
+			fail(types.FieldPerCPU, err)
+		} else {
+			nodeMetric.CPUs = cores
+		}
+	}
+
+	// System Metric
+	if systemMetric, err := CollectNodeSystemMetric(); err != nil {
+		fail(types.FieldSystem, err)
+	} else {
+		nodeMetric.BootID = systemMetric.BootID
+		nodeMetric.BootTime = systemMetric.BootTime
+		nodeMetric.Uptime = systemMetric.Uptime
+		nodeMetric.Load1 = systemMetric.Load1
+		nodeMetric.Load5 = systemMetric.Load5
+		nodeMetric.Load15 = systemMetric.Load15
+		nodeMetric.ContextSwitches = systemMetric.ContextSwitches
+		nodeMetric.ProcsTotal = systemMetric.ProcsTotal
+		nodeMetric.ProcsRunning = systemMetric.ProcsRunning
+		nodeMetric.ProcsBlocked = systemMetric.ProcsBlocked
 	}
 
 	// Memory Metric
-	memoryMetric, err := CollectNodeMemoryMetric()
-	if err != nil {
-		return types.NodeMetric{}, fmt.Errorf("failed to collect node memory metric: %w", err)
+	if memoryMetric, err := CollectNodeMemoryMetric(); err != nil {
+		fail(types.FieldMemory, err)
+	} else {
+		nodeMetric.MemoryTotal = memoryMetric.Total
+		nodeMetric.MemoryAvailable = memoryMetric.Available
+		nodeMetric.MemoryUsed = memoryMetric.Used
+		nodeMetric.MemoryBuffers = memoryMetric.Buffers
+		nodeMetric.MemoryCached = memoryMetric.Cached
+		nodeMetric.MemoryDirty = memoryMetric.Dirty
+		nodeMetric.MemorySlabReclaimable = memoryMetric.SlabReclaimable
+		nodeMetric.MemorySlabUnreclaimable = memoryMetric.SlabUnreclaimable
+		nodeMetric.SwapTotal = memoryMetric.SwapTotal
+		nodeMetric.SwapUsed = memoryMetric.SwapUsed
+		nodeMetric.HugePagesTotal = memoryMetric.HugePagesTotal
+		nodeMetric.HugePagesFree = memoryMetric.HugePagesFree
+		nodeMetric.HugePageSize = memoryMetric.HugePageSize
 	}
 
 	// Disk Metric
-	diskMetric, err := CollectNodeDiskMetric()
-	if err != nil {
-		return types.NodeMetric{}, fmt.Errorf("failed to collect node disk metric: %w", err)
+	if diskMetric, err := CollectNodeDiskMetric(); err != nil {
+		fail(types.FieldDisk, err)
+	} else {
+		nodeMetric.DiskReadBytes = diskMetric.ReadBytes
+		nodeMetric.DiskWriteBytes = diskMetric.WriteBytes
+		nodeMetric.Disks = diskMetric.Devices
 	}
 
 	// Network Metric
-	networkMetric, err := CollectNodeNetworkMetric()
-	if err != nil {
-		return types.NodeMetric{}, fmt.Errorf("failed to collect node network metric: %w", err)
-	}
-
-	return types.NodeMetric{
-		NodeName:        metadata.NodeName,
-		CPUTotal:        cpuMetric.Total,
-		CPUBusy:         cpuMetric.Busy,
-		CPUCount:        cpuMetric.Count,
-		MemoryTotal:     memoryMetric.Total,
-		MemoryAvailable: memoryMetric.Available,
-		MemoryUsed:      memoryMetric.Used,
-		DiskReadBytes:   diskMetric.ReadBytes,
-		DiskWriteBytes:  diskMetric.WriteBytes,
-		NetworkRxBytes:  networkMetric.RxBytes,
-		NetworkTxBytes:  networkMetric.TxBytes,
-	}, nil
+	if networkMetric, err := CollectNodeNetworkMetric(); err != nil {
+		fail(types.FieldNetwork, err)
+	} else {
+		nodeMetric.NetworkRxBytes = networkMetric.RxBytes
+		nodeMetric.NetworkTxBytes = networkMetric.TxBytes
+		nodeMetric.Interfaces = networkMetric.Interfaces
+	}
+
+	// Pressure Metric
+	if pressureMetric, err := CollectNodePressureMetric(); err != nil {
+		fail(types.FieldPressure, err)
+	} else {
+		nodeMetric.Pressure = pressureMetric
+	}
+
+	// Filesystem Metric
+	if filesystemMetric, err := CollectNodeFilesystemMetric(); err != nil {
+		fail(types.FieldFilesystems, err)
+	} else {
+		nodeMetric.Filesystems = filesystemMetric
+	}
+
+	return nodeMetric, errs
 }
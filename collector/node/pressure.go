@@ -0,0 +1,101 @@
+package node
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+var pressureResources = []string{"cpu", "memory", "io"}
+
+// CollectNodePressureMetric 은 /proc/pressure 에서 노드 전체의 PSI 값을 수집합니다
+// 커널이 PSI 를 지원하지 않으면 빈 목록을 반환합니다
+func CollectNodePressureMetric() ([]types.PressureMetric, error) {
+	var pressures []types.PressureMetric
+	for _, resource := range pressureResources {
+		pressure, err := readPressureFile(filepath.Join(config.ProcRoot, "pressure", resource), resource)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pressures = append(pressures, pressure)
+	}
+
+	return pressures, nil
+}
+
+func readPressureFile(path, resource string) (types.PressureMetric, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return types.PressureMetric{}, err
+	}
+	defer f.Close()
+
+	pressure, err := parsePressure(f, resource)
+	if err != nil {
+		return types.PressureMetric{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return pressure, nil
+}
+
+// parsePressure 는 다음 형식의 PSI 파일을 파싱합니다
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func parsePressure(r io.Reader, resource string) (types.PressureMetric, error) {
+	pressure := types.PressureMetric{Resource: resource}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var avg10, avg60, avg300 *float64
+		var total *uint64
+		switch fields[0] {
+		case "some":
+			avg10, avg60, avg300, total = &pressure.SomeAvg10, &pressure.SomeAvg60, &pressure.SomeAvg300, &pressure.SomeTotal
+		case "full":
+			avg10, avg60, avg300, total = &pressure.FullAvg10, &pressure.FullAvg60, &pressure.FullAvg300, &pressure.FullTotal
+		default:
+			continue
+		}
+
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return pressure, fmt.Errorf("invalid field %q", field)
+			}
+
+			var err error
+			switch key {
+			case "avg10":
+				*avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				*avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				*avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				*total, err = strconv.ParseUint(value, 10, 64)
+			}
+			if err != nil {
+				return pressure, fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+		}
+	}
+
+	return pressure, scanner.Err()
+}
@@ -0,0 +1,69 @@
+package node
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+func TestParsePressure(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		content  string
+		want     types.PressureMetric
+	}{
+		{
+			name:     "some and full",
+			resource: "memory",
+			content: "some avg10=1.50 avg60=0.75 avg300=0.25 total=123456\n" +
+				"full avg10=0.50 avg60=0.10 avg300=0.05 total=6789\n",
+			want: types.PressureMetric{
+				Resource:  "memory",
+				SomeAvg10: 1.5, SomeAvg60: 0.75, SomeAvg300: 0.25, SomeTotal: 123456,
+				FullAvg10: 0.5, FullAvg60: 0.1, FullAvg300: 0.05, FullTotal: 6789,
+			},
+		},
+		{
+			// 커널 5.13 이전의 cpu 파일에는 full 줄이 없습니다
+			name:     "some only",
+			resource: "cpu",
+			content:  "some avg10=2.00 avg60=1.00 avg300=0.50 total=999\n",
+			want:     types.PressureMetric{Resource: "cpu", SomeAvg10: 2, SomeAvg60: 1, SomeAvg300: 0.5, SomeTotal: 999},
+		},
+		{
+			name:     "unknown lines and keys",
+			resource: "io",
+			content:  "\nsome avg10=0.00 avg60=0.00 avg300=0.00 total=10 extra=1\nother avg10=9.99\n",
+			want:     types.PressureMetric{Resource: "io", SomeTotal: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePressure(strings.NewReader(tt.content), tt.resource)
+		if err != nil {
+			t.Fatalf("%s: parsePressure() error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: parsePressure() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParsePressureInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing separator", "some avg10 avg60=0.00 avg300=0.00 total=0\n"},
+		{"invalid avg", "some avg10=abc avg60=0.00 avg300=0.00 total=0\n"},
+		{"negative total", "full avg10=0.00 avg60=0.00 avg300=0.00 total=-1\n"},
+	}
+
+	for _, tt := range tests {
+		if _, err := parsePressure(strings.NewReader(tt.content), "io"); err == nil {
+			t.Errorf("%s: parsePressure() error = nil, want error", tt.name)
+		}
+	}
+}
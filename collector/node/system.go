@@ -0,0 +1,80 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/load"
+)
+
+type NodeSystemMetric struct {
+	BootID          string  `json:"bootId"`
+	BootTime        uint64  `json:"bootTime"` // unix seconds
+	Uptime          uint64  `json:"uptime"`   // seconds
+	Load1           float64 `json:"load1"`
+	Load5           float64 `json:"load5"`
+	Load15          float64 `json:"load15"`
+	ContextSwitches uint64  `json:"contextSwitches"`
+	ProcsTotal      uint64  `json:"procsTotal"`
+	ProcsRunning    uint64  `json:"procsRunning"`
+	ProcsBlocked    uint64  `json:"procsBlocked"`
+}
+
+func (s NodeSystemMetric) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// CollectNodeSystemMetric 은 노드의 boot ID, 가동 시간, 부하 평균과 프로세스 통계를 수집합니다
+// boot ID 는 재부팅마다 바뀌므로 누적 카운터가 초기화되었는지 판단하는 데 사용합니다
+func CollectNodeSystemMetric() (NodeSystemMetric, error) {
+	bootID, err := readBootID()
+	if err != nil {
+		return NodeSystemMetric{}, err
+	}
+
+	bootTime, err := host.BootTime()
+	if err != nil {
+		return NodeSystemMetric{}, fmt.Errorf("failed to get boot time: %w", err)
+	}
+	uptime, err := host.Uptime()
+	if err != nil {
+		return NodeSystemMetric{}, fmt.Errorf("failed to get uptime: %w", err)
+	}
+
+	avg, err := load.Avg()
+	if err != nil {
+		return NodeSystemMetric{}, fmt.Errorf("failed to get load average: %w", err)
+	}
+	misc, err := load.Misc()
+	if err != nil {
+		return NodeSystemMetric{}, fmt.Errorf("failed to get process statistics: %w", err)
+	}
+
+	return NodeSystemMetric{
+		BootID:          bootID,
+		BootTime:        bootTime,
+		Uptime:          uptime,
+		Load1:           avg.Load1,
+		Load5:           avg.Load5,
+		Load15:          avg.Load15,
+		ContextSwitches: uint64(misc.Ctxt),
+		ProcsTotal:      uint64(misc.ProcsTotal),
+		ProcsRunning:    uint64(misc.ProcsRunning),
+		ProcsBlocked:    uint64(misc.ProcsBlocked),
+	}, nil
+}
+
+// readBootID 는 커널이 부팅마다 새로 생성하는 boot ID 를 읽습니다
+func readBootID() (string, error) {
+	data, err := os.ReadFile(filepath.Join(config.ProcRoot, "sys", "kernel", "random", "boot_id"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read boot id: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
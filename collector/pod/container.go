@@ -0,0 +1,61 @@
+package pod
+
+import (
+	"errors"
+	"log"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cri"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+// CollectContainerMetrics 는 파드 cgroup 하위에 있는 컨테이너별 메트릭을 수집합니다
+// 수집에 실패한 컨테이너는 제외하고 에러 목록에 기록합니다
+func CollectContainerMetrics(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) ([]types.ContainerMetric, []types.CollectError) {
+	containerMetrics := []types.ContainerMetric{}
+	var errs []types.CollectError
+	for _, containerCgroup := range containerCgroups {
+		metric, err := collectSingleContainerMetric(layout, containerCgroup)
+		if err != nil {
+			log.Printf("failed to collect metrics for container %s: %v", containerCgroup.ID, err)
+			errs = append(errs, types.CollectError{Subsystem: "container", ContainerID: containerCgroup.ID, Message: err.Error()})
+			continue
+		}
+		containerMetrics = append(containerMetrics, metric)
+	}
+
+	return containerMetrics, errs
+}
+
+// collectSingleContainerMetric 은 단일 컨테이너의 메트릭을 수집합니다
+func collectSingleContainerMetric(layout cgroup.Layout, containerCgroup cgroup.ContainerCgroup) (types.ContainerMetric, error) {
+	metrics, err := cgroup.Stat(layout, containerCgroup.Path)
+	if err != nil {
+		return types.ContainerMetric{}, err
+	}
+	if metrics.CPU == nil || metrics.Memory == nil || metrics.Io == nil {
+		return types.ContainerMetric{}, errors.New("cpu, memory or io controller is not available")
+	}
+
+	diskMetric := CollectPodDiskMetric(metrics.Io.Usage)
+
+	metric := types.ContainerMetric{
+		ID:               containerCgroup.ID,
+		CPUUsageUsec:     metrics.CPU.UsageUsec,
+		MemoryUsage:      metrics.Memory.Usage,
+		DiskReadBytes:    diskMetric.ReadBytes,
+		DiskWriteBytes:   diskMetric.WriteBytes,
+		CPUNrPeriods:     metrics.CPU.NrPeriods,
+		CPUNrThrottled:   metrics.CPU.NrThrottled,
+		CPUThrottledUsec: metrics.CPU.ThrottledUsec,
+	}
+
+	// CRI 런타임에서 컨테이너 이름, 이미지, 재시작 횟수 조회
+	if container, ok := cri.LookupContainer(containerCgroup.ID); ok {
+		metric.Name = container.Name
+		metric.Image = container.Image
+		metric.RestartCount = container.RestartCount
+	}
+
+	return metric, nil
+}
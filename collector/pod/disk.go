@@ -1,11 +1,80 @@
 package pod
 
-import "github.com/containerd/cgroups/v3/cgroup2/stats"
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 
-func CollectPodDiskMetric(entries []*stats.IOEntry) (readBytes, writeBytes uint64) {
+	"github.com/containerd/cgroups/v3/cgroup2/stats"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+type PodDiskMetric struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadCount  uint64
+	WriteCount uint64
+	Devices    []types.DeviceIOMetric
+}
+
+// CollectPodDiskMetric 은 cgroup io.stat 의 장치별 항목과 그 합계를 수집합니다
+func CollectPodDiskMetric(entries []*stats.IOEntry) PodDiskMetric {
+	diskMetric := PodDiskMetric{
+		Devices: []types.DeviceIOMetric{},
+	}
 	for _, entry := range entries {
-		readBytes += entry.Rbytes
-		writeBytes += entry.Wbytes
+		diskMetric.ReadBytes += entry.Rbytes
+		diskMetric.WriteBytes += entry.Wbytes
+		diskMetric.ReadCount += entry.Rios
+		diskMetric.WriteCount += entry.Wios
+		diskMetric.Devices = append(diskMetric.Devices, types.DeviceIOMetric{
+			Device:     blockDeviceName(entry.Major, entry.Minor),
+			Major:      entry.Major,
+			Minor:      entry.Minor,
+			ReadBytes:  entry.Rbytes,
+			WriteBytes: entry.Wbytes,
+			ReadCount:  entry.Rios,
+			WriteCount: entry.Wios,
+		})
+	}
+
+	sort.Slice(diskMetric.Devices, func(i, j int) bool {
+		return diskMetric.Devices[i].Device < diskMetric.Devices[j].Device
+	})
+
+	return diskMetric
+}
+
+// blockDeviceNames 는 major:minor 에 해당하는 장치명 캐시입니다
+// 장치명은 장치가 연결되어 있는 동안 바뀌지 않으므로 찾은 이름만 저장합니다
+var (
+	blockDeviceNamesMu sync.RWMutex
+	blockDeviceNames   = make(map[string]string)
+)
+
+// blockDeviceName 은 major:minor 번호를 호스트의 /sys/dev/block 에서 찾아 장치명(sda, nvme0n1, dm-0 등)으로 변환합니다
+// 찾지 못하면 major:minor 를 그대로 사용합니다
+func blockDeviceName(major, minor uint64) string {
+	id := fmt.Sprintf("%d:%d", major, minor)
+
+	blockDeviceNamesMu.RLock()
+	name, ok := blockDeviceNames[id]
+	blockDeviceNamesMu.RUnlock()
+	if ok {
+		return name
 	}
-	return readBytes, writeBytes
+
+	target, err := os.Readlink(filepath.Join(config.HostRoot, "/sys/dev/block", id))
+	if err != nil {
+		return id
+	}
+	name = filepath.Base(target)
+
+	blockDeviceNamesMu.Lock()
+	blockDeviceNames[id] = name
+	blockDeviceNamesMu.Unlock()
+	return name
 }
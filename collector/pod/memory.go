@@ -0,0 +1,54 @@
+package pod
+
+import "github.com/containerd/cgroups/v3/cgroup2/stats"
+
+type PodMemoryMetric struct {
+	Usage      uint64
+	WorkingSet uint64
+	RSS        uint64
+	Cache      uint64
+	Kernel     uint64
+	Sock       uint64
+	Swap       uint64
+}
+
+// CollectPodMemoryMetric 은 cgroup 메모리 통계에서 사용량 종류별 메모리 메트릭을 계산합니다
+// working set 은 kubelet 과 동일하게 전체 사용량에서 inactive_file 을 뺀 값입니다
+func CollectPodMemoryMetric(memory *stats.MemoryStat) PodMemoryMetric {
+	workingSet := uint64(0)
+	if memory.Usage > memory.InactiveFile {
+		workingSet = memory.Usage - memory.InactiveFile
+	}
+
+	return PodMemoryMetric{
+		Usage:      memory.Usage,
+		WorkingSet: workingSet,
+		RSS:        memory.Anon,
+		Cache:      memory.File,
+		Kernel:     memory.KernelStack + memory.Slab,
+		Sock:       memory.Sock,
+		Swap:       memory.SwapUsage,
+	}
+}
+
+type PodMemoryEventsMetric struct {
+	High    uint64
+	Max     uint64
+	Oom     uint64
+	OomKill uint64
+}
+
+// CollectPodMemoryEventsMetric 은 cgroup memory.events 의 누적 이벤트 횟수를 반환합니다
+// cgroup v2 의 memory.events 는 하위 컨테이너 cgroup 에서 발생한 이벤트를 포함합니다
+func CollectPodMemoryEventsMetric(events *stats.MemoryEvents) PodMemoryEventsMetric {
+	if events == nil {
+		return PodMemoryEventsMetric{}
+	}
+
+	return PodMemoryEventsMetric{
+		High:    events.High,
+		Max:     events.Max,
+		Oom:     events.Oom,
+		OomKill: events.OomKill,
+	}
+}
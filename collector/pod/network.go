@@ -5,43 +5,94 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 	"github.com/shirou/gopsutil/v4/net"
 )
 
-func CollectPodNetworkMetric(podPath string) (rxBytes, txBytes uint64, err error) {
-	containerPid, err := getContainerPID(podPath)
+// loopbackInterface 는 파드 네트워크 네임스페이스의 루프백 인터페이스입니다
+const loopbackInterface = "lo"
+
+type PodNetworkMetric struct {
+	RxBytes    uint64
+	TxBytes    uint64
+	Interfaces []types.InterfaceMetric
+}
+
+// CollectPodNetworkMetric 은 파드 네트워크 네임스페이스의 인터페이스별 네트워크 메트릭과 그 합계를 수집합니다
+// 루프백 트래픽은 파드 밖으로 나가지 않으므로 합계에서 제외하고, 인터페이스 목록에는 포함합니다
+func CollectPodNetworkMetric(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) (PodNetworkMetric, error) {
+	containerPid, err := getContainerPID(layout, containerCgroups)
 	if err != nil {
-		return 0, 0, err
+		return PodNetworkMetric{}, err
 	}
 
-	path := fmt.Sprintf("/proc/%d/net/dev", containerPid)
-	netIOCounters, err := net.IOCountersByFileWithContext(context.Background(), false, path)
+	path := filepath.Join(config.ProcRoot, strconv.Itoa(containerPid), "net", "dev")
+	netIOCounters, err := net.IOCountersByFileWithContext(context.Background(), true, path)
 	if err != nil {
-		return 0, 0, err
+		return PodNetworkMetric{}, err
+	}
+
+	networkMetric := PodNetworkMetric{
+		Interfaces: []types.InterfaceMetric{},
 	}
-	return netIOCounters[0].BytesRecv, netIOCounters[0].BytesSent, nil
+	for _, netIOCounter := range netIOCounters {
+		if netIOCounter.Name != loopbackInterface {
+			networkMetric.RxBytes += netIOCounter.BytesRecv
+			networkMetric.TxBytes += netIOCounter.BytesSent
+		}
+		networkMetric.Interfaces = append(networkMetric.Interfaces, types.InterfaceMetric{
+			Name:      netIOCounter.Name,
+			RxBytes:   netIOCounter.BytesRecv,
+			TxBytes:   netIOCounter.BytesSent,
+			RxPackets: netIOCounter.PacketsRecv,
+			TxPackets: netIOCounter.PacketsSent,
+			RxErrors:  netIOCounter.Errin,
+			TxErrors:  netIOCounter.Errout,
+			RxDropped: netIOCounter.Dropin,
+			TxDropped: netIOCounter.Dropout,
+		})
+	}
+
+	sort.Slice(networkMetric.Interfaces, func(i, j int) bool {
+		return networkMetric.Interfaces[i].Name < networkMetric.Interfaces[j].Name
+	})
+
+	return networkMetric, nil
 }
 
-// getContainerPID는 파드에 속한 컨테이너 중 하나의 PID를 반환합니다
-func getContainerPID(podPath string) (int, error) {
-	entries, err := os.ReadDir(podPath)
+// IsHostNetwork 는 파드가 호스트 네트워크 네임스페이스를 사용하는지(hostNetwork: true) 확인합니다
+// 컨테이너 프로세스와 호스트 PID 1 의 네트워크 네임스페이스 링크(net:[inode])를 비교합니다
+// hostNetwork 파드의 네트워크 메트릭은 노드 전체의 값이므로 파드 값으로 합산하면 안 됩니다
+func IsHostNetwork(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) (bool, error) {
+	containerPid, err := getContainerPID(layout, containerCgroups)
 	if err != nil {
-		return 0, err
-	}
-	for _, entry := range entries {
-		if entry.IsDir() && len(entry.Name()) == 64 {
-			procsFile := filepath.Join(podPath, entry.Name(), "cgroup.procs")
-			data, err := os.ReadFile(procsFile)
-			if err != nil {
-				continue
-			}
-			var pid int
-			_, err = fmt.Sscanf(string(data), "%d", &pid)
-			if err == nil && pid > 0 {
-				return pid, nil
-			}
+		return false, err
+	}
+
+	hostNetns, err := os.Readlink(filepath.Join(config.ProcRoot, "1", "ns", "net"))
+	if err != nil {
+		return false, err
+	}
+	podNetns, err := os.Readlink(filepath.Join(config.ProcRoot, strconv.Itoa(containerPid), "ns", "net"))
+	if err != nil {
+		return false, err
+	}
+	return podNetns == hostNetns, nil
+}
+
+// getContainerPID는 파드에 속한 컨테이너 중 하나의 PID를 반환합니다
+func getContainerPID(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) (int, error) {
+	for _, containerCgroup := range containerCgroups {
+		pids, err := layout.Procs(containerCgroup.Path)
+		if err != nil || len(pids) == 0 {
+			continue
 		}
+		return pids[0], nil
 	}
-	return 0, fmt.Errorf("no container PID found in pod cgroup: %s", podPath)
+	return 0, fmt.Errorf("no container PID found in %d container cgroups", len(containerCgroups))
 }
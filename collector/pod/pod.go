@@ -1,96 +1,220 @@
 package pod
 
 import (
+	"errors"
 	"fmt"
 	"log"
-	"os"
-	"path/filepath"
 
-	"github.com/containerd/cgroups/v3/cgroup2"
-	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/metadata"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cri"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/kubelet"
 	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
 )
 
-func CollectPodMetrics() ([]types.PodMetric, error) {
-	podPaths, err := getPodCgroupPaths()
+// CollectPodMetrics 는 노드의 모든 파드 메트릭을 수집합니다
+// 파드 cgroup 통계를 읽지 못한 파드는 제외하고, 일부 필드 묶음만 실패한 파드는 Invalid 에 기록하여 포함합니다
+func CollectPodMetrics() ([]types.PodMetric, []types.CollectError) {
+	// cgroup 버전과 드라이버 판별
+	layout, err := cgroup.DetectLayout(config.CgroupRoot)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pod cgroup paths: %w", err)
+		return nil, []types.CollectError{{Subsystem: "pod", Message: fmt.Sprintf("failed to detect cgroup layout: %v", err)}}
+	}
+	resolver := cgroup.NewPathResolver(layout)
+
+	podCgroups, err := resolver.PodCgroups()
+	if err != nil {
+		return nil, []types.CollectError{{Subsystem: "pod", Message: fmt.Sprintf("failed to get pod cgroup paths: %v", err)}}
 	}
 
 	var podMetrics []types.PodMetric
-	for _, podPath := range podPaths {
-		metric, err := collectSinglePodMetric(podPath)
+	var errs []types.CollectError
+	for _, podCgroup := range podCgroups {
+		metric, podErrs, err := collectSinglePodMetric(layout, resolver, podCgroup)
+		errs = append(errs, podErrs...)
 		if err != nil {
-			log.Printf("failed to collect metrics for pod %s: %v", podPath, err)
+			log.Printf("failed to collect metrics for pod %s: %v", podCgroup.Path, err)
+			errs = append(errs, types.CollectError{Subsystem: "pod", PodUID: podCgroup.UID, Message: err.Error()})
 			continue
 		}
 		podMetrics = append(podMetrics, metric)
 	}
 
-	return podMetrics, nil
+	return podMetrics, errs
 }
 
 // collectSinglePodMetric은 단일 파드의 메트릭을 수집합니다
-func collectSinglePodMetric(podPath string) (types.PodMetric, error) {
-	resource := podPath[14:]
-
-	// cgroup 매니저 로드
-	manager, err := cgroup2.Load(resource)
+// 파드 cgroup 통계를 읽지 못하면 error 를, 일부 필드 묶음만 실패하면 해당 실패 목록을 반환합니다
+func collectSinglePodMetric(layout cgroup.Layout, resolver cgroup.PathResolver, podCgroup cgroup.PodCgroup) (types.PodMetric, []types.CollectError, error) {
+	// 기본 메트릭 수집
+	metrics, err := cgroup.Stat(layout, podCgroup.Path)
 	if err != nil {
-		return types.PodMetric{}, fmt.Errorf("failed to load cgroup manager: %w", err)
+		return types.PodMetric{}, nil, err
 	}
 
-	// 기본 메트릭 수집
-	metrics, err := manager.Stat()
-	if err != nil {
-		return types.PodMetric{}, fmt.Errorf("failed to get cgroup stats: %w", err)
+	podMetric := types.PodMetric{
+		UID:      podCgroup.UID,
+		QoSClass: string(podCgroup.QoSClass()),
+	}
+
+	// kubelet 에서 파드 이름과 네임스페이스 조회
+	// kubelet 에서 찾지 못하면 CRI 런타임의 컨테이너 레이블을 사용합니다
+	if pod, ok := kubelet.LookupPod(podCgroup.UID); ok {
+		podMetric.Name = pod.Name
+		podMetric.Namespace = pod.Namespace
+	} else {
+		podMetric.Name, podMetric.Namespace = lookupPodFromContainers(resolver, podCgroup)
+	}
+	var errs []types.CollectError
+	fail := func(field string, err error) {
+		podMetric.Invalid = append(podMetric.Invalid, field)
+		errs = append(errs, types.CollectError{Subsystem: "pod." + field, PodUID: podCgroup.UID, Message: err.Error()})
+	}
+
+	// CPU 메트릭 수집
+	if metrics.CPU != nil {
+		podMetric.CPUUsageUsec = metrics.CPU.UsageUsec
+		podMetric.CPUNrPeriods = metrics.CPU.NrPeriods
+		podMetric.CPUNrThrottled = metrics.CPU.NrThrottled
+		podMetric.CPUThrottledUsec = metrics.CPU.ThrottledUsec
+	} else {
+		fail(types.FieldCPU, errors.New("cpu controller is not available"))
+	}
+
+	// 메모리 메트릭 수집
+	if metrics.Memory != nil {
+		memoryMetric := CollectPodMemoryMetric(metrics.Memory)
+		podMetric.MemoryUsage = memoryMetric.Usage
+		podMetric.MemoryWorkingSet = memoryMetric.WorkingSet
+		podMetric.MemoryRSS = memoryMetric.RSS
+		podMetric.MemoryCache = memoryMetric.Cache
+		podMetric.MemoryKernel = memoryMetric.Kernel
+		podMetric.MemorySock = memoryMetric.Sock
+		podMetric.MemorySwap = memoryMetric.Swap
+	} else {
+		fail(types.FieldMemory, errors.New("memory controller is not available"))
+	}
+	if metrics.MemoryEvents != nil {
+		memoryEventsMetric := CollectPodMemoryEventsMetric(metrics.MemoryEvents)
+		podMetric.MemoryHighEvents = memoryEventsMetric.High
+		podMetric.MemoryMaxEvents = memoryEventsMetric.Max
+		podMetric.OomEvents = memoryEventsMetric.Oom
+		podMetric.OomKillEvents = memoryEventsMetric.OomKill
+	} else {
+		fail(types.FieldMemoryEvents, errors.New("memory.events is not available"))
 	}
 
 	// 디스크 메트릭 수집
-	diskReadBytes, diskWriteBytes := CollectPodDiskMetric(metrics.Io.Usage)
+	if metrics.Io != nil {
+		diskMetric := CollectPodDiskMetric(metrics.Io.Usage)
+		podMetric.DiskReadBytes = diskMetric.ReadBytes
+		podMetric.DiskWriteBytes = diskMetric.WriteBytes
+		podMetric.DiskReadCount = diskMetric.ReadCount
+		podMetric.DiskWriteCount = diskMetric.WriteCount
+		podMetric.Disks = diskMetric.Devices
+	} else {
+		fail(types.FieldDisk, errors.New("io controller is not available"))
+	}
+
+	// 프로세스 수 메트릭 수집
+	if metrics.Pids != nil {
+		pidsMetric := CollectPodPidsMetric(metrics.Pids)
+		podMetric.PidsCurrent = pidsMetric.Current
+		podMetric.PidsMax = pidsMetric.Max
+	} else {
+		fail(types.FieldPids, errors.New("pids controller is not available"))
+	}
+
+	// CPU, 메모리 제한 수집
+	if limits, err := cgroup.ReadLimits(layout, podCgroup.Path); err != nil {
+		fail(types.FieldLimits, fmt.Errorf("failed to read cgroup limits: %w", err))
+	} else {
+		podMetric.CPUQuotaUsec = limits.CPUQuotaUsec
+		podMetric.CPUPeriodUsec = limits.CPUPeriodUsec
+		podMetric.CPUWeight = limits.CPUWeight
+		podMetric.MemoryMax = limits.MemoryMax
+		podMetric.MemoryHigh = limits.MemoryHigh
+	}
+
+	podMetric.Pressure = CollectPodPressureMetric(metrics)
+
+	// 컨테이너 cgroup 목록 조회
+	// 목록이 없으면 컨테이너 단위로 수집하는 네트워크, TCP, 스토리지, 파일 디스크립터, 컨테이너 메트릭을 모두 수집할 수 없습니다
+	containerCgroups, err := resolver.ContainerCgroups(podCgroup)
+	if err != nil {
+		err = fmt.Errorf("failed to list container cgroups: %w", err)
+		fail(types.FieldNetwork, err)
+		fail(types.FieldTCP, err)
+		fail(types.FieldStorage, err)
+		fail(types.FieldFds, err)
+		fail(types.FieldContainers, err)
+		return podMetric, errs, nil
+	}
+
+	// hostNetwork 파드 판별
+	// 판별하지 못해도 네트워크 메트릭은 수집할 수 있으므로 필드 묶음을 무효로 표시하지 않고 에러만 기록합니다
+	if hostNetwork, err := IsHostNetwork(layout, containerCgroups); err != nil {
+		errs = append(errs, types.CollectError{Subsystem: "pod.hostNetwork", PodUID: podCgroup.UID, Message: err.Error()})
+	} else {
+		podMetric.HostNetwork = hostNetwork
+	}
 
 	// 네트워크 메트릭 수집
-	networkRxBytes, networkTxBytes, err := CollectPodNetworkMetric(podPath)
+	// hostNetwork 파드는 노드 전체의 값이 수집되며, HostNetwork 로 표시하여 파드 값으로 합산되지 않도록 합니다
+	if networkMetric, err := CollectPodNetworkMetric(layout, containerCgroups); err != nil {
+		fail(types.FieldNetwork, err)
+	} else {
+		podMetric.NetworkRxBytes = networkMetric.RxBytes
+		podMetric.NetworkTxBytes = networkMetric.TxBytes
+		podMetric.Interfaces = networkMetric.Interfaces
+	}
+
+	// TCP 연결 상태 및 카운터 수집
+	if tcpMetric, err := CollectPodTCPMetric(layout, containerCgroups); err != nil {
+		fail(types.FieldTCP, err)
+	} else {
+		podMetric.TCP = tcpMetric
+	}
+
+	// 임시 스토리지(emptyDir, 쓰기 레이어) 사용량 수집
+	storageMetric, err := CollectPodStorageMetric(layout, podCgroup.UID, containerCgroups)
 	if err != nil {
-		return types.PodMetric{}, fmt.Errorf("failed to collect network metrics: %w", err)
-	}
-
-	// UID 추출
-	uid := extractPodUID(resource)
-
-	return types.PodMetric{
-		Namespace:      metadata.Namespace,
-		UID:            uid,
-		CPUUsageUsec:   metrics.CPU.UsageUsec,
-		MemoryUsage:    metrics.Memory.Usage,
-		DiskReadBytes:  diskReadBytes,
-		DiskWriteBytes: diskWriteBytes,
-		NetworkRxBytes: networkRxBytes,
-		NetworkTxBytes: networkTxBytes,
-	}, nil
-}
+		fail(types.FieldStorage, err)
+	} else {
+		podMetric.EphemeralEmptyDirBytes = storageMetric.EmptyDirBytes
+		podMetric.EphemeralWritableLayerBytes = storageMetric.WritableLayerBytes
+	}
 
-// extractPodUID는 resource 경로에서 파드 UID를 추출합니다
-func extractPodUID(resource string) string {
-	if len(resource) >= 36 {
-		return resource[len(resource)-36:]
+	// 열린 파일 디스크립터 수 수집
+	openFds, err := CollectPodFdMetric(layout, containerCgroups)
+	if err != nil {
+		fail(types.FieldFds, err)
+	} else {
+		podMetric.OpenFds = openFds
+	}
+
+	// 컨테이너별 메트릭 수집
+	containerMetrics, containerErrs := CollectContainerMetrics(layout, containerCgroups)
+	for _, containerErr := range containerErrs {
+		containerErr.PodUID = podCgroup.UID
+		errs = append(errs, containerErr)
 	}
-	return ""
+	podMetric.Containers = containerMetrics
+
+	return podMetric, errs, nil
 }
 
-func getPodCgroupPaths() ([]string, error) {
-	var pods []string
-	err := filepath.Walk("/sys/fs/cgroup/kubepods/", func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() && len(info.Name()) > 3 && info.Name()[:3] == "pod" {
-			pods = append(pods, path)
-		}
-		return nil
-	})
+// lookupPodFromContainers 는 CRI 런타임의 컨테이너 레이블에서 파드 이름과 네임스페이스를 찾습니다
+func lookupPodFromContainers(resolver cgroup.PathResolver, podCgroup cgroup.PodCgroup) (name, namespace string) {
+	containerCgroups, err := resolver.ContainerCgroups(podCgroup)
 	if err != nil {
-		return nil, err
+		return "", ""
+	}
+
+	for _, containerCgroup := range containerCgroups {
+		if container, ok := cri.LookupContainer(containerCgroup.ID); ok && container.PodName != "" {
+			return container.PodName, container.PodNamespace
+		}
 	}
-	return pods, nil
+	return "", ""
 }
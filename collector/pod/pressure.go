@@ -0,0 +1,39 @@
+package pod
+
+import (
+	"github.com/containerd/cgroups/v3/cgroup2/stats"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+// CollectPodPressureMetric 은 파드 cgroup 의 cpu.pressure, memory.pressure, io.pressure 값을 변환합니다
+// cgroup v1 이거나 커널이 PSI 를 지원하지 않으면 해당 자원은 제외됩니다
+func CollectPodPressureMetric(metrics *stats.Metrics) []types.PressureMetric {
+	var pressures []types.PressureMetric
+	if metrics.CPU != nil && metrics.CPU.PSI != nil {
+		pressures = append(pressures, toPressureMetric("cpu", metrics.CPU.PSI))
+	}
+	if metrics.Memory != nil && metrics.Memory.PSI != nil {
+		pressures = append(pressures, toPressureMetric("memory", metrics.Memory.PSI))
+	}
+	if metrics.Io != nil && metrics.Io.PSI != nil {
+		pressures = append(pressures, toPressureMetric("io", metrics.Io.PSI))
+	}
+	return pressures
+}
+
+func toPressureMetric(resource string, psi *stats.PSIStats) types.PressureMetric {
+	pressure := types.PressureMetric{Resource: resource}
+	if some := psi.GetSome(); some != nil {
+		pressure.SomeAvg10 = some.Avg10
+		pressure.SomeAvg60 = some.Avg60
+		pressure.SomeAvg300 = some.Avg300
+		pressure.SomeTotal = some.Total
+	}
+	if full := psi.GetFull(); full != nil {
+		pressure.FullAvg10 = full.Avg10
+		pressure.FullAvg60 = full.Avg60
+		pressure.FullAvg300 = full.Avg300
+		pressure.FullTotal = full.Total
+	}
+	return pressure
+}
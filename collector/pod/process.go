@@ -0,0 +1,75 @@
+package pod
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/containerd/cgroups/v3/cgroup2/stats"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+)
+
+type PodPidsMetric struct {
+	Current uint64
+	Max     uint64 // 0 은 제한 없음
+}
+
+// CollectPodPidsMetric 은 cgroup pids 통계에서 현재 프로세스(스레드) 수와 제한을 반환합니다
+// pids.max 가 "max" 이면 cgroups 라이브러리는 MaxUint64 를 반환하므로 제한 없음(0)으로 변환합니다
+func CollectPodPidsMetric(pids *stats.PidsStat) PodPidsMetric {
+	limit := pids.Limit
+	if limit == math.MaxUint64 {
+		limit = 0
+	}
+	return PodPidsMetric{
+		Current: pids.Current,
+		Max:     limit,
+	}
+}
+
+// CollectPodFdMetric 은 파드의 모든 컨테이너 프로세스가 열고 있는 파일 디스크립터 수의 합을 계산합니다
+// 집계 도중 종료된 프로세스는 건너뜁니다
+func CollectPodFdMetric(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) (uint64, error) {
+	var openFds uint64
+	for _, containerCgroup := range containerCgroups {
+		pids, err := layout.Procs(containerCgroup.Path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // 컨테이너가 종료됨
+			}
+			return 0, fmt.Errorf("failed to read procs of container %s: %w", containerCgroup.ID, err)
+		}
+
+		for _, pid := range pids {
+			count, err := countFds(pid)
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					continue
+				}
+				return 0, fmt.Errorf("failed to count fds of pid %d: %w", pid, err)
+			}
+			openFds += count
+		}
+	}
+	return openFds, nil
+}
+
+// countFds 는 /proc/<pid>/fd 의 항목 수를 반환합니다
+func countFds(pid int) (uint64, error) {
+	dir, err := os.Open(filepath.Join(config.ProcRoot, strconv.Itoa(pid), "fd"))
+	if err != nil {
+		return 0, err
+	}
+	defer dir.Close()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(names)), nil
+}
@@ -0,0 +1,202 @@
+package pod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+)
+
+// tmpfsMagic 은 statfs 로 확인한 tmpfs 의 파일시스템 타입 값입니다
+const tmpfsMagic = 0x01021994
+
+type PodStorageMetric struct {
+	EmptyDirBytes      uint64
+	WritableLayerBytes uint64
+}
+
+// CollectPodStorageMetric 은 파드의 emptyDir 볼륨과 컨테이너 쓰기 레이어의 디스크 사용량을 계산합니다
+// 메모리(tmpfs) 기반 emptyDir 는 메모리 사용량으로 집계되므로 제외합니다
+func CollectPodStorageMetric(layout cgroup.Layout, podUID string, containerCgroups []cgroup.ContainerCgroup) (PodStorageMetric, error) {
+	emptyDirBytes, err := collectEmptyDirUsage(podUID)
+	if err != nil {
+		return PodStorageMetric{}, fmt.Errorf("failed to collect emptyDir usage: %w", err)
+	}
+
+	var writableLayerBytes uint64
+	for _, containerCgroup := range containerCgroups {
+		pids, err := layout.Procs(containerCgroup.Path)
+		if err != nil || len(pids) == 0 {
+			continue
+		}
+
+		upperDir, err := findOverlayUpperDir(pids[0])
+		if err != nil || upperDir == "" {
+			continue
+		}
+
+		usage, err := cachedDiskUsage(filepath.Join(config.HostRoot, upperDir))
+		if err != nil {
+			return PodStorageMetric{}, fmt.Errorf("failed to collect writable layer usage for container %s: %w", containerCgroup.ID, err)
+		}
+		writableLayerBytes += usage
+	}
+
+	return PodStorageMetric{
+		EmptyDirBytes:      emptyDirBytes,
+		WritableLayerBytes: writableLayerBytes,
+	}, nil
+}
+
+// collectEmptyDirUsage 는 kubelet 의 파드 볼륨 디렉터리 아래 디스크 기반 emptyDir 사용량을 합산합니다
+func collectEmptyDirUsage(podUID string) (uint64, error) {
+	dir := filepath.Join(config.HostRoot, config.KubeletRoot, "pods", podUID, "volumes", "kubernetes.io~empty-dir")
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var total uint64
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+
+		var statfs syscall.Statfs_t
+		if err := syscall.Statfs(path, &statfs); err == nil && statfs.Type == tmpfsMagic {
+			continue
+		}
+
+		usage, err := cachedDiskUsage(path)
+		if err != nil {
+			return 0, err
+		}
+		total += usage
+	}
+	return total, nil
+}
+
+// findOverlayUpperDir 는 프로세스의 루트 마운트가 overlay 인 경우 upperdir 경로를 반환합니다
+func findOverlayUpperDir(pid int) (string, error) {
+	f, err := os.Open(filepath.Join(config.ProcRoot, strconv.Itoa(pid), "mountinfo"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// mountinfo 형식: ID parentID major:minor root mountpoint options [optional...] - fstype source superOptions
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[4] != "/" {
+			continue
+		}
+
+		separator := -1
+		for i, field := range fields {
+			if field == "-" {
+				separator = i
+				break
+			}
+		}
+		if separator < 0 || separator+3 >= len(fields) || fields[separator+1] != "overlay" {
+			continue
+		}
+
+		for _, option := range strings.Split(fields[separator+3], ",") {
+			if upperDir, ok := strings.CutPrefix(option, "upperdir="); ok {
+				return upperDir, nil
+			}
+		}
+	}
+
+	return "", scanner.Err()
+}
+
+type diskUsageEntry struct {
+	bytes      uint64
+	measuredAt time.Time
+}
+
+// diskUsageCache 는 경로별로 마지막으로 계산한 디스크 사용량입니다
+var (
+	diskUsageMu    sync.Mutex
+	diskUsageCache = make(map[string]diskUsageEntry)
+)
+
+// cachedDiskUsage 는 config.StorageRefreshInterval 이 지나지 않았으면 이전에 계산한 디스크 사용량을 반환합니다
+// 삭제된 파드, 컨테이너의 경로는 더 이상 조회되지 않으므로 오래된 항목은 계산할 때마다 정리합니다
+func cachedDiskUsage(root string) (uint64, error) {
+	now := time.Now()
+
+	diskUsageMu.Lock()
+	entry, ok := diskUsageCache[root]
+	diskUsageMu.Unlock()
+	if ok && now.Sub(entry.measuredAt) < config.StorageRefreshInterval {
+		return entry.bytes, nil
+	}
+
+	usage, err := diskUsage(root)
+	if err != nil {
+		return 0, err
+	}
+
+	diskUsageMu.Lock()
+	defer diskUsageMu.Unlock()
+	diskUsageCache[root] = diskUsageEntry{bytes: usage, measuredAt: now}
+	for path, entry := range diskUsageCache {
+		if now.Sub(entry.measuredAt) > 2*config.StorageRefreshInterval {
+			delete(diskUsageCache, path)
+		}
+	}
+	return usage, nil
+}
+
+// diskUsage 는 du 와 같이 디렉터리 아래 파일들이 실제로 차지하는 블록 크기를 합산합니다
+// 하드 링크는 한 번만 계산하며, 순회 중 삭제된 파일은 무시합니다
+func diskUsage(root string) (uint64, error) {
+	var total uint64
+	seenInodes := make(map[uint64]bool)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			total += uint64(info.Size())
+			return nil
+		}
+		if stat.Nlink > 1 && !d.IsDir() {
+			if seenInodes[stat.Ino] {
+				return nil
+			}
+			seenInodes[stat.Ino] = true
+		}
+		total += uint64(stat.Blocks) * 512
+		return nil
+	})
+	return total, err
+}
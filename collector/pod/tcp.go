@@ -0,0 +1,142 @@
+package pod
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/cgroup"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/collector/config"
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+// /proc/net/tcp 의 st 컬럼 값입니다 (include/net/tcp_states.h)
+const (
+	tcpEstablished = 0x01
+	tcpSynSent     = 0x02
+	tcpSynRecv     = 0x03
+	tcpFinWait1    = 0x04
+	tcpFinWait2    = 0x05
+	tcpTimeWait    = 0x06
+	tcpCloseWait   = 0x08
+	tcpLastAck     = 0x09
+	tcpListen      = 0x0A
+)
+
+// CollectPodTCPMetric 은 파드 네트워크 네임스페이스의 TCP 연결 상태별 개수와 TCP 카운터를 수집합니다
+// 파드 컨테이너 중 하나의 /proc/<pid>/net 아래 파일을 읽으므로 네트워크 네임스페이스를 공유하는 파드 전체의 값입니다
+func CollectPodTCPMetric(layout cgroup.Layout, containerCgroups []cgroup.ContainerCgroup) (types.TCPMetric, error) {
+	containerPid, err := getContainerPID(layout, containerCgroups)
+	if err != nil {
+		return types.TCPMetric{}, err
+	}
+
+	netDir := filepath.Join(config.ProcRoot, strconv.Itoa(containerPid), "net")
+
+	var tcpMetric types.TCPMetric
+	for _, name := range []string{"tcp", "tcp6"} {
+		if err := countTCPStates(filepath.Join(netDir, name), &tcpMetric); err != nil {
+			// IPv6 가 비활성화된 커널에는 tcp6 가 없습니다
+			if name == "tcp6" && os.IsNotExist(err) {
+				continue
+			}
+			return types.TCPMetric{}, err
+		}
+	}
+
+	snmp, err := readProcNetStat(filepath.Join(netDir, "snmp"))
+	if err != nil {
+		return types.TCPMetric{}, err
+	}
+	tcpMetric.OutSegs = snmp["Tcp"]["OutSegs"]
+	tcpMetric.RetransSegs = snmp["Tcp"]["RetransSegs"]
+
+	netstat, err := readProcNetStat(filepath.Join(netDir, "netstat"))
+	if err != nil {
+		return types.TCPMetric{}, err
+	}
+	tcpMetric.ListenOverflows = netstat["TcpExt"]["ListenOverflows"]
+	tcpMetric.ListenDrops = netstat["TcpExt"]["ListenDrops"]
+
+	return tcpMetric, nil
+}
+
+// countTCPStates 는 /proc/net/tcp 형식의 파일에서 연결 상태별 개수를 더합니다
+func countTCPStates(path string, tcpMetric *types.TCPMetric) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Scan() // 헤더
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		state, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			continue
+		}
+
+		switch state {
+		case tcpEstablished:
+			tcpMetric.Established++
+		case tcpSynSent:
+			tcpMetric.SynSent++
+		case tcpSynRecv:
+			tcpMetric.SynRecv++
+		case tcpFinWait1, tcpFinWait2:
+			tcpMetric.FinWait++
+		case tcpTimeWait:
+			tcpMetric.TimeWait++
+		case tcpCloseWait:
+			tcpMetric.CloseWait++
+		case tcpLastAck:
+			tcpMetric.LastAck++
+		case tcpListen:
+			tcpMetric.Listen++
+		}
+	}
+	return scanner.Err()
+}
+
+// readProcNetStat 은 /proc/net/snmp, /proc/net/netstat 형식의 파일을 읽어 프로토콜별 카운터를 반환합니다
+// 각 프로토콜은 "Tcp: 이름..." 줄과 "Tcp: 값..." 줄의 쌍으로 나타납니다
+// MaxConn 처럼 음수일 수 있는 항목은 필요하지 않으므로 건너뜁니다
+func readProcNetStat(path string) (map[string]map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	counters := make(map[string]map[string]uint64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		names := strings.Fields(scanner.Text())
+		if !scanner.Scan() {
+			break
+		}
+		values := strings.Fields(scanner.Text())
+		if len(names) == 0 || len(names) != len(values) || names[0] != values[0] {
+			return nil, fmt.Errorf("malformed line in %s: %q", path, names)
+		}
+
+		protocol := strings.TrimSuffix(names[0], ":")
+		counters[protocol] = make(map[string]uint64, len(names)-1)
+		for i := 1; i < len(names); i++ {
+			value, err := strconv.ParseUint(values[i], 10, 64)
+			if err != nil {
+				continue
+			}
+			counters[protocol][names[i]] = value
+		}
+	}
+	return counters, scanner.Err()
+}
@@ -0,0 +1,109 @@
+package pod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCountTCPStates(t *testing.T) {
+	tcp := writeFile(t, `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1001 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:1F90 0100007F:A1B2 01 00000000:00000000 00:00000000 00000000     0        0 1002 1 0000000000000000 20 4 30 10 -1
+   2: 0100007F:1F90 0100007F:A1B3 01 00000000:00000000 00:00000000 00000000     0        0 1003 1 0000000000000000 20 4 30 10 -1
+   3: 0100007F:A1B4 0100007F:1F90 02 00000000:00000000 00:00000000 00000000     0        0 1004 1 0000000000000000 20 4 30 10 -1
+   4: 0100007F:1F90 0100007F:A1B5 03 00000000:00000000 00:00000000 00000000     0        0 1005 1 0000000000000000 20 4 30 10 -1
+   5: 0100007F:1F90 0100007F:A1B6 04 00000000:00000000 00:00000000 00000000     0        0 1006 1 0000000000000000 20 4 30 10 -1
+   6: 0100007F:1F90 0100007F:A1B7 05 00000000:00000000 00:00000000 00000000     0        0 1007 1 0000000000000000 20 4 30 10 -1
+   7: 0100007F:1F90 0100007F:A1B8 06 00000000:00000000 00:00000000 00000000     0        0 1008 1 0000000000000000 20 4 30 10 -1
+   8: 0100007F:1F90 0100007F:A1B9 08 00000000:00000000 00:00000000 00000000     0        0 1009 1 0000000000000000 20 4 30 10 -1
+   9: 0100007F:1F90 0100007F:A1BA 09 00000000:00000000 00:00000000 00000000     0        0 1010 1 0000000000000000 20 4 30 10 -1
+  10: 0100007F:1F90 0100007F:A1BB 07 00000000:00000000 00:00000000 00000000     0        0 1011 1 0000000000000000 20 4 30 10 -1
+  11: malformed
+`)
+	tcp6 := writeFile(t, `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:0050 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2001 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:0050 00000000000000000000000001000000:C350 01 00000000:00000000 00:00000000 00000000     0        0 2002 1 0000000000000000 20 4 30 10 -1
+`)
+
+	var got types.TCPMetric
+	for _, path := range []string{tcp, tcp6} {
+		if err := countTCPStates(path, &got); err != nil {
+			t.Fatalf("countTCPStates(%s) error: %v", path, err)
+		}
+	}
+
+	// CLOSE(07) 상태와 형식이 맞지 않는 줄은 세지 않습니다
+	want := types.TCPMetric{Established: 3, SynSent: 1, SynRecv: 1, FinWait: 2, TimeWait: 1, CloseWait: 1, LastAck: 1, Listen: 2}
+	if got != want {
+		t.Errorf("countTCPStates() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCountTCPStatesMissingFile(t *testing.T) {
+	var tcpMetric types.TCPMetric
+	if err := countTCPStates(filepath.Join(t.TempDir(), "tcp6"), &tcpMetric); !os.IsNotExist(err) {
+		t.Errorf("countTCPStates(missing) error = %v, want not exist", err)
+	}
+}
+
+func TestReadProcNetStat(t *testing.T) {
+	path := writeFile(t, `Ip: Forwarding DefaultTTL InReceives
+Ip: 1 64 12345
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens OutSegs RetransSegs
+Tcp: 1 200 120000 -1 42 9876 12
+`)
+
+	got, err := readProcNetStat(path)
+	if err != nil {
+		t.Fatalf("readProcNetStat() error: %v", err)
+	}
+
+	tests := []struct {
+		protocol string
+		name     string
+		want     uint64
+	}{
+		{"Ip", "InReceives", 12345},
+		{"Tcp", "ActiveOpens", 42},
+		{"Tcp", "OutSegs", 9876},
+		{"Tcp", "RetransSegs", 12},
+	}
+	for _, tt := range tests {
+		if value := got[tt.protocol][tt.name]; value != tt.want {
+			t.Errorf("%s %s = %d, want %d", tt.protocol, tt.name, value, tt.want)
+		}
+	}
+
+	// 음수인 MaxConn 은 건너뜁니다
+	if _, ok := got["Tcp"]["MaxConn"]; ok {
+		t.Error("Tcp MaxConn is present, want skipped")
+	}
+}
+
+func TestReadProcNetStatMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"value count mismatch", "Tcp: ActiveOpens OutSegs\nTcp: 42\n"},
+		{"protocol mismatch", "Tcp: ActiveOpens\nUdp: 42\n"},
+	}
+
+	for _, tt := range tests {
+		if _, err := readProcNetStat(writeFile(t, tt.content)); err == nil {
+			t.Errorf("%s: readProcNetStat() error = nil, want error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ilcm96/dku-ce-k8s-metrics-server/shared/types"
+)
+
+const initialBackoff = time.Second
+
+// Pusher 는 샘플을 제한된 크기의 큐에 모아 aggregator ingest 엔드포인트로 전송합니다
+// 전송에 실패한 샘플은 큐에 남겨 두고 지수 백오프로 재시도합니다
+type Pusher struct {
+	url        string
+	interval   time.Duration
+	maxBackoff time.Duration
+	client     *http.Client
+
+	mu      sync.Mutex
+	queue   []types.Metric
+	size    int
+	dropped uint64
+}
+
+func NewPusher(url string, interval time.Duration, queueSize int, maxBackoff time.Duration) *Pusher {
+	return &Pusher{
+		url:        url,
+		interval:   interval,
+		maxBackoff: maxBackoff,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		size:       queueSize,
+	}
+}
+
+// Enqueue 는 샘플을 전송 대기 큐에 추가합니다
+// 큐가 가득 차면 가장 오래된 샘플을 버립니다
+func (p *Pusher) Enqueue(metric types.Metric) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.queue) >= p.size {
+		p.queue = p.queue[1:]
+		p.dropped++
+		slog.Warn("push queue is full, dropping oldest sample", "dropped", p.dropped)
+	}
+	p.queue = append(p.queue, metric)
+}
+
+// Run 은 interval 마다 대기 중인 샘플을 오래된 순서로 전송합니다
+func (p *Pusher) Run() {
+	backoff := initialBackoff
+	for {
+		if err := p.flush(); err != nil {
+			wait := jitter(backoff)
+			slog.Error("failed to push metrics", "url", p.url, "retryIn", wait.String(), "error", err)
+			time.Sleep(wait)
+			backoff = min(backoff*2, p.maxBackoff)
+			continue
+		}
+
+		backoff = initialBackoff
+		time.Sleep(p.interval)
+	}
+}
+
+// flush 는 큐가 빌 때까지 샘플을 하나씩 전송합니다
+// 전송에 성공하거나 재시도해도 성공할 수 없는 샘플만 큐에서 제거합니다
+func (p *Pusher) flush() error {
+	for {
+		metric, ok := p.peek()
+		if !ok {
+			return nil
+		}
+
+		retryable, err := p.send(metric)
+		if err != nil && retryable {
+			return err
+		}
+		if err != nil {
+			slog.Error("dropping metric rejected by aggregator", "timestamp", metric.Timestamp, "error", err)
+		}
+		p.pop(metric)
+	}
+}
+
+func (p *Pusher) peek() (types.Metric, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.queue) == 0 {
+		return types.Metric{}, false
+	}
+	return p.queue[0], true
+}
+
+// pop 은 전송한 샘플이 아직 큐의 맨 앞에 있으면 제거합니다
+// 전송 중에 큐가 넘쳐 이미 버려졌을 수 있으므로 타임스탬프로 확인합니다
+func (p *Pusher) pop(metric types.Metric) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.queue) > 0 && p.queue[0].Timestamp.Equal(metric.Timestamp) {
+		p.queue = p.queue[1:]
+	}
+}
+
+// send 는 샘플 하나를 전송합니다
+// 같은 샘플은 재시도해도 같은 Idempotency-Key 를 가지므로 aggregator 에 한 번만 저장됩니다
+func (p *Pusher) send(metric types.Metric) (retryable bool, err error) {
+	body, err := json.Marshal(metric)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", idempotencyKey(metric))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+// idempotencyKey 는 노드명과 샘플 타임스탬프로 샘플을 식별하는 키를 생성합니다
+// aggregator 가 pull 모드에서 사용하는 키와 같은 형식입니다
+func idempotencyKey(metric types.Metric) string {
+	return metric.NodeMetric.NodeName + "/" + metric.Timestamp.UTC().Format(time.RFC3339Nano)
+}
+
+// jitter 는 여러 수집기가 동시에 재시도하지 않도록 대기 시간을 ±20% 범위에서 흔듭니다
+func jitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * (0.8 + 0.4*rand.Float64()))
+}
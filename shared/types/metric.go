@@ -6,9 +6,10 @@ import (
 )
 
 type Metric struct {
-	Timestamp  time.Time   `json:"timestamp"`
-	NodeMetric NodeMetric  `json:"nodeMetric"`
-	PodMetric  []PodMetric `json:"podMetric"`
+	Timestamp  time.Time      `json:"timestamp"`
+	NodeMetric NodeMetric     `json:"nodeMetric"`
+	PodMetric  []PodMetric    `json:"podMetric"`
+	Errors     []CollectError `json:"errors,omitempty"`
 }
 
 func (m Metric) String() string {
@@ -16,6 +17,51 @@ func (m Metric) String() string {
 	return string(s)
 }
 
+// 수집에 실패할 수 있는 필드 묶음입니다
+// 실패한 묶음은 NodeMetric.Invalid, PodMetric.Invalid 에 기록되며 해당 필드의 값은 0 으로 남습니다
+const (
+	FieldCPU          = "cpu"
+	FieldMemory       = "memory"
+	FieldMemoryEvents = "memoryEvents"
+	FieldDisk         = "disk"
+	FieldNetwork      = "network"
+	FieldPressure     = "pressure"
+	FieldFilesystems  = "filesystems"
+	FieldStorage      = "storage"
+	FieldContainers   = "containers"
+	FieldPids         = "pids"
+	FieldFds          = "fds"
+	FieldPerCPU       = "perCpu"
+	FieldSystem       = "system"
+	FieldTCP          = "tcp"
+	FieldLimits       = "limits"
+)
+
+// CollectError 는 수집 중 실패한 하위 시스템과 그 원인입니다
+type CollectError struct {
+	Subsystem   string `json:"subsystem"` // 예: node.cpu, pod, pod.network, container
+	PodUID      string `json:"podUid,omitempty"`
+	ContainerID string `json:"containerId,omitempty"`
+	Message     string `json:"message"`
+}
+
+func (e CollectError) String() string {
+	s, _ := json.Marshal(e)
+	return string(s)
+}
+
+// isValid 는 필드 묶음이 invalid 목록에 없는지 확인합니다
+func isValid(invalid []string, fields ...string) bool {
+	for _, field := range fields {
+		for _, f := range invalid {
+			if f == field {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 type NodeMetric struct {
 	NodeName        string  `json:"nodeName"`
 	CPUTotal        float64 `json:"cpuTotal"`
@@ -28,6 +74,38 @@ type NodeMetric struct {
 	DiskWriteBytes  uint64  `json:"diskWriteBytes"`
 	NetworkRxBytes  uint64  `json:"networkRxBytes"`
 	NetworkTxBytes  uint64  `json:"networkTxBytes"`
+
+	MemoryBuffers           uint64 `json:"memoryBuffers"`
+	MemoryCached            uint64 `json:"memoryCached"`
+	MemoryDirty             uint64 `json:"memoryDirty"`
+	MemorySlabReclaimable   uint64 `json:"memorySlabReclaimable"`
+	MemorySlabUnreclaimable uint64 `json:"memorySlabUnreclaimable"`
+	SwapTotal               uint64 `json:"swapTotal"`
+	SwapUsed                uint64 `json:"swapUsed"`
+	HugePagesTotal          uint64 `json:"hugePagesTotal"` // 페이지 수
+	HugePagesFree           uint64 `json:"hugePagesFree"`  // 페이지 수
+	HugePageSize            uint64 `json:"hugePageSize"`   // bytes
+
+	BootID          string  `json:"bootId"`   // 재부팅마다 바뀜
+	BootTime        uint64  `json:"bootTime"` // unix seconds
+	Uptime          uint64  `json:"uptime"`   // seconds
+	Load1           float64 `json:"load1"`
+	Load5           float64 `json:"load5"`
+	Load15          float64 `json:"load15"`
+	ContextSwitches uint64  `json:"contextSwitches"`
+	ProcsTotal      uint64  `json:"procsTotal"`
+	ProcsRunning    uint64  `json:"procsRunning"`
+	ProcsBlocked    uint64  `json:"procsBlocked"`
+
+	CPUModes CPUTimes        `json:"cpuModes"`
+	CPUs     []CPUCoreMetric `json:"cpus"` // PER_CPU_METRICS 가 false 이면 비어 있음
+
+	Disks       []DiskMetric       `json:"disks"`
+	Interfaces  []InterfaceMetric  `json:"interfaces"`
+	Pressure    []PressureMetric   `json:"pressure"`
+	Filesystems []FilesystemMetric `json:"filesystems"`
+
+	Invalid []string `json:"invalid,omitempty"` // 수집에 실패한 필드 묶음
 }
 
 func (n NodeMetric) String() string {
@@ -35,18 +113,242 @@ func (n NodeMetric) String() string {
 	return string(s)
 }
 
+// IsValid 는 주어진 필드 묶음이 모두 정상적으로 수집되었는지 확인합니다
+func (n NodeMetric) IsValid(fields ...string) bool {
+	return isValid(n.Invalid, fields...)
+}
+
+// CPUTimes 는 /proc/stat 의 CPU 모드별 누적 시간(초)입니다
+// guest, guestNice 는 커널이 user, nice 에 이미 포함하여 집계하므로 합산하면 안 됩니다
+type CPUTimes struct {
+	User      float64 `json:"user"`
+	Nice      float64 `json:"nice"`
+	System    float64 `json:"system"`
+	Idle      float64 `json:"idle"`
+	Iowait    float64 `json:"iowait"`
+	Irq       float64 `json:"irq"`
+	Softirq   float64 `json:"softirq"`
+	Steal     float64 `json:"steal"`
+	Guest     float64 `json:"guest"`
+	GuestNice float64 `json:"guestNice"`
+}
+
+// Total 은 guest 시간을 제외한 모든 모드의 합입니다
+func (t CPUTimes) Total() float64 {
+	return t.User + t.Nice + t.System + t.Idle + t.Iowait + t.Irq + t.Softirq + t.Steal
+}
+
+// Busy 는 idle, iowait 와 하이퍼바이저가 가져간 steal 을 제외한 시간입니다
+func (t CPUTimes) Busy() float64 {
+	return t.User + t.Nice + t.System + t.Irq + t.Softirq
+}
+
+type CPUCoreMetric struct {
+	CPU string `json:"cpu"` // 예: cpu0
+	CPUTimes
+}
+
+func (c CPUCoreMetric) String() string {
+	s, _ := json.Marshal(c)
+	return string(s)
+}
+
+type DiskMetric struct {
+	Device           string `json:"device"`
+	ReadBytes        uint64 `json:"readBytes"`
+	WriteBytes       uint64 `json:"writeBytes"`
+	ReadCount        uint64 `json:"readCount"`
+	WriteCount       uint64 `json:"writeCount"`
+	MergedReadCount  uint64 `json:"mergedReadCount"`
+	MergedWriteCount uint64 `json:"mergedWriteCount"`
+	ReadTime         uint64 `json:"readTime"`       // ms
+	WriteTime        uint64 `json:"writeTime"`      // ms
+	IoTime           uint64 `json:"ioTime"`         // ms
+	WeightedIoTime   uint64 `json:"weightedIoTime"` // ms
+}
+
+func (d DiskMetric) String() string {
+	s, _ := json.Marshal(d)
+	return string(s)
+}
+
+type InterfaceMetric struct {
+	Name      string `json:"name"`
+	RxBytes   uint64 `json:"rxBytes"`
+	TxBytes   uint64 `json:"txBytes"`
+	RxPackets uint64 `json:"rxPackets"`
+	TxPackets uint64 `json:"txPackets"`
+	RxErrors  uint64 `json:"rxErrors"`
+	TxErrors  uint64 `json:"txErrors"`
+	RxDropped uint64 `json:"rxDropped"`
+	TxDropped uint64 `json:"txDropped"`
+}
+
+func (i InterfaceMetric) String() string {
+	s, _ := json.Marshal(i)
+	return string(s)
+}
+
+type FilesystemMetric struct {
+	Mountpoint  string `json:"mountpoint"`
+	Device      string `json:"device"`
+	FsType      string `json:"fsType"`
+	SizeBytes   uint64 `json:"sizeBytes"`
+	UsedBytes   uint64 `json:"usedBytes"`
+	AvailBytes  uint64 `json:"availBytes"` // 일반 사용자가 사용 가능한 용량 (예약 블록 제외)
+	InodesTotal uint64 `json:"inodesTotal"`
+	InodesUsed  uint64 `json:"inodesUsed"`
+}
+
+func (f FilesystemMetric) String() string {
+	s, _ := json.Marshal(f)
+	return string(s)
+}
+
 type PodMetric struct {
-	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`      // kubelet 에서 조회하지 못하면 비어 있음
+	Namespace      string `json:"namespace"` // kubelet 에서 조회하지 못하면 비어 있음
 	UID            string `json:"uid"`
+	QoSClass       string `json:"qosClass"` // Guaranteed, Burstable, BestEffort (cgroup 경로에서 판별)
 	CPUUsageUsec   uint64 `json:"cpuUsageUsec"`
 	MemoryUsage    uint64 `json:"memoryUsage"`
 	DiskReadBytes  uint64 `json:"diskReadBytes"`
 	DiskWriteBytes uint64 `json:"diskWriteBytes"`
-	NetworkRxBytes uint64 `json:"networkRxBytes"`
-	NetworkTxBytes uint64 `json:"networkTxBytes"`
+	NetworkRxBytes uint64 `json:"networkRxBytes"` // 루프백 제외
+	NetworkTxBytes uint64 `json:"networkTxBytes"` // 루프백 제외
+
+	// HostNetwork 는 파드가 호스트 네트워크 네임스페이스를 사용하는지 여부입니다
+	// true 이면 네트워크, TCP 메트릭은 노드 전체의 값입니다
+	HostNetwork bool `json:"hostNetwork"`
+
+	Interfaces []InterfaceMetric `json:"interfaces"` // 루프백 포함
+
+	DiskReadCount  uint64           `json:"diskReadCount"`
+	DiskWriteCount uint64           `json:"diskWriteCount"`
+	Disks          []DeviceIOMetric `json:"disks"`
+
+	MemoryWorkingSet uint64 `json:"memoryWorkingSet"`
+	MemoryRSS        uint64 `json:"memoryRss"`
+	MemoryCache      uint64 `json:"memoryCache"`
+	MemoryKernel     uint64 `json:"memoryKernel"`
+	MemorySock       uint64 `json:"memorySock"`
+	MemorySwap       uint64 `json:"memorySwap"`
+
+	CPUNrPeriods     uint64 `json:"cpuNrPeriods"`
+	CPUNrThrottled   uint64 `json:"cpuNrThrottled"`
+	CPUThrottledUsec uint64 `json:"cpuThrottledUsec"`
+
+	// 파드 cgroup 의 CPU, 메모리 제한이며 0 은 제한 없음입니다
+	// 파드의 모든 컨테이너에 limits 가 지정된 경우에만 파드 cgroup 에 제한이 설정됩니다
+	CPUQuotaUsec  uint64 `json:"cpuQuotaUsec"`
+	CPUPeriodUsec uint64 `json:"cpuPeriodUsec"`
+	CPUWeight     uint64 `json:"cpuWeight"` // 1~10000, CPU requests 합계에서 계산됨
+	MemoryMax     uint64 `json:"memoryMax"`
+	MemoryHigh    uint64 `json:"memoryHigh"`
+
+	MemoryHighEvents uint64 `json:"memoryHighEvents"`
+	MemoryMaxEvents  uint64 `json:"memoryMaxEvents"`
+	OomEvents        uint64 `json:"oomEvents"`
+	OomKillEvents    uint64 `json:"oomKillEvents"`
+
+	EphemeralEmptyDirBytes      uint64 `json:"ephemeralEmptyDirBytes"`
+	EphemeralWritableLayerBytes uint64 `json:"ephemeralWritableLayerBytes"`
+
+	PidsCurrent uint64 `json:"pidsCurrent"`
+	PidsMax     uint64 `json:"pidsMax"` // 0 은 제한 없음
+	OpenFds     uint64 `json:"openFds"`
+
+	TCP TCPMetric `json:"tcp"`
+
+	Containers []ContainerMetric `json:"containers"`
+	Pressure   []PressureMetric  `json:"pressure"`
+
+	Invalid []string `json:"invalid,omitempty"` // 수집에 실패한 필드 묶음
 }
 
 func (p PodMetric) String() string {
 	s, _ := json.Marshal(p)
 	return string(s)
 }
+
+// IsValid 는 주어진 필드 묶음이 모두 정상적으로 수집되었는지 확인합니다
+func (p PodMetric) IsValid(fields ...string) bool {
+	return isValid(p.Invalid, fields...)
+}
+
+// TCPMetric 은 네트워크 네임스페이스의 TCP 연결 상태별 개수와 누적 카운터입니다
+type TCPMetric struct {
+	Established uint64 `json:"established"`
+	SynSent     uint64 `json:"synSent"`
+	SynRecv     uint64 `json:"synRecv"`
+	FinWait     uint64 `json:"finWait"` // FIN_WAIT1 + FIN_WAIT2
+	TimeWait    uint64 `json:"timeWait"`
+	CloseWait   uint64 `json:"closeWait"`
+	LastAck     uint64 `json:"lastAck"`
+	Listen      uint64 `json:"listen"`
+
+	OutSegs         uint64 `json:"outSegs"`         // snmp Tcp OutSegs
+	RetransSegs     uint64 `json:"retransSegs"`     // snmp Tcp RetransSegs
+	ListenOverflows uint64 `json:"listenOverflows"` // netstat TcpExt ListenOverflows (accept 큐 초과)
+	ListenDrops     uint64 `json:"listenDrops"`     // netstat TcpExt ListenDrops
+}
+
+func (t TCPMetric) String() string {
+	s, _ := json.Marshal(t)
+	return string(s)
+}
+
+// DeviceIOMetric 은 cgroup io.stat 의 블록 장치별 I/O 통계입니다
+type DeviceIOMetric struct {
+	Device     string `json:"device"` // 장치명을 찾지 못하면 major:minor
+	Major      uint64 `json:"major"`
+	Minor      uint64 `json:"minor"`
+	ReadBytes  uint64 `json:"readBytes"`
+	WriteBytes uint64 `json:"writeBytes"`
+	ReadCount  uint64 `json:"readCount"`
+	WriteCount uint64 `json:"writeCount"`
+}
+
+func (d DeviceIOMetric) String() string {
+	s, _ := json.Marshal(d)
+	return string(s)
+}
+
+type ContainerMetric struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`  // CRI 런타임에서 조회하지 못하면 비어 있음
+	Image          string `json:"image"` // CRI 런타임에서 조회하지 못하면 비어 있음
+	RestartCount   uint64 `json:"restartCount"`
+	CPUUsageUsec   uint64 `json:"cpuUsageUsec"`
+	MemoryUsage    uint64 `json:"memoryUsage"`
+	DiskReadBytes  uint64 `json:"diskReadBytes"`
+	DiskWriteBytes uint64 `json:"diskWriteBytes"`
+
+	CPUNrPeriods     uint64 `json:"cpuNrPeriods"`
+	CPUNrThrottled   uint64 `json:"cpuNrThrottled"`
+	CPUThrottledUsec uint64 `json:"cpuThrottledUsec"`
+}
+
+func (c ContainerMetric) String() string {
+	s, _ := json.Marshal(c)
+	return string(s)
+}
+
+// PressureMetric 은 PSI(Pressure Stall Information) 값입니다
+// some 은 일부 태스크가, full 은 모든 태스크가 자원을 기다리며 멈춘 시간의 비율(%)입니다
+type PressureMetric struct {
+	Resource   string  `json:"resource"` // cpu, memory, io
+	SomeAvg10  float64 `json:"someAvg10"`
+	SomeAvg60  float64 `json:"someAvg60"`
+	SomeAvg300 float64 `json:"someAvg300"`
+	SomeTotal  uint64  `json:"someTotal"` // usec
+	FullAvg10  float64 `json:"fullAvg10"`
+	FullAvg60  float64 `json:"fullAvg60"`
+	FullAvg300 float64 `json:"fullAvg300"`
+	FullTotal  uint64  `json:"fullTotal"` // usec
+}
+
+func (p PressureMetric) String() string {
+	s, _ := json.Marshal(p)
+	return string(s)
+}